@@ -0,0 +1,11 @@
+package sonarsweep
+
+import (
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/solutions/solutionstest"
+)
+
+func FuzzParse(f *testing.F) {
+	solutionstest.FuzzParse(f, 2021, 1)
+}
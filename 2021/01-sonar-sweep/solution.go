@@ -0,0 +1,109 @@
+package sonarsweep
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"math"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+type State struct {
+	Increasing int
+	Values     []int
+}
+
+func NewState() *State {
+	return &State{
+		Values: make([]int, 0, 2000),
+	}
+}
+
+func (s *State) AddValue(v int) {
+	s.Values = append(s.Values, v)
+}
+
+func (s *State) SingleIncreasing() (increasing int) {
+	lastVal := math.MaxInt
+	for _, n := range s.Values {
+		if n > lastVal {
+			increasing++
+		}
+		lastVal = n
+	}
+	return
+}
+
+func (s *State) WindowedIncreasing() (increasing int) {
+	lastVal := math.MaxInt
+	for i := 0; i < len(s.Values)-2; i++ {
+		sum := 0
+		for _, n := range s.Values[i : i+3] {
+			sum += n
+		}
+		if sum > lastVal {
+			increasing++
+		}
+		lastVal = sum
+	}
+	return
+}
+
+type Solution struct {
+	State *State
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "sonar sweep",
+		Year:    2021,
+		Problem: 1,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(7),
+				Expect2: solutions.Int(5),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(1342),
+				Expect2: solutions.Int(1378),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.State = NewState()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		var n int
+		if n, err = util.Atoi(lineNo, 1, scanner.Text()); err != nil {
+			return
+		}
+		s.State.AddValue(n)
+	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(s.State.SingleIncreasing()), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(s.State.WindowedIncreasing()), nil
+}
@@ -0,0 +1,146 @@
+package dive
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+type Command struct {
+	Direction string
+	Units     int
+}
+
+func NewCommand(line string) (c Command, err error) {
+	fields := util.Fields(line)
+	if l := len(fields); l != 2 {
+		err = util.Errorf(0, 0, line, "expected direction and units")
+		return
+	}
+
+	switch fields[0].Text {
+	case "forward", "down", "up":
+	default:
+		err = util.Errorf(0, fields[0].Col, fields[0].Text, "expected forward, down or up")
+		return
+	}
+
+	c = Command{
+		Direction: fields[0].Text,
+	}
+	c.Units, err = util.Atoi(0, fields[1].Col, fields[1].Text)
+	return
+}
+
+func (c Command) X() int {
+	if c.Direction == "forward" {
+		return c.Units
+	}
+	return 0
+}
+
+func (c Command) Depth() int {
+	if c.Direction == "up" {
+		return -c.Units
+	}
+	if c.Direction == "down" {
+		return c.Units
+	}
+	return 0
+}
+
+type Commands struct {
+	commands []Command
+}
+
+func NewCommands() *Commands {
+	return &Commands{
+		commands: make([]Command, 0, 2000),
+	}
+}
+
+func (cs *Commands) AddCommand(c Command) {
+	cs.commands = append(cs.commands, c)
+}
+
+func (cs Commands) Position() (x int, depth int) {
+	for _, c := range cs.commands {
+		x += c.X()
+		depth += c.Depth()
+	}
+	return
+}
+
+func (cs Commands) PositionWithAim() (x int, depth int) {
+	aim := 0
+	for _, c := range cs.commands {
+		aim += c.Depth()
+		if cx := c.X(); cx > 0 {
+			x += cx
+			depth += aim * cx
+		}
+	}
+	return
+}
+
+type Solution struct {
+	Commands *Commands
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "dive",
+		Year:    2021,
+		Problem: 2,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(150),
+				Expect2: solutions.Int(900),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(1488669),
+				Expect2: solutions.Int(1176514794),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Commands = NewCommands()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		var c Command
+		if c, err = NewCommand(scanner.Text()); err != nil {
+			return util.AtLine(err, lineNo)
+		}
+		s.Commands.AddCommand(c)
+	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	x, depth := s.Commands.Position()
+	return solutions.Int(x * depth), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	x, depth := s.Commands.PositionWithAim()
+	return solutions.Int(x * depth), nil
+}
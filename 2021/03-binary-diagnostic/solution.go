@@ -1,13 +1,27 @@
-package main
+package binarydiagnostic
 
 import (
 	"bufio"
-	"log"
-	"os"
+	"bytes"
+	_ "embed"
+	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
 type Count struct {
 	Zeros int
 	Ones  int
@@ -140,44 +154,80 @@ func (r *Report) CO2ScrubberRating() (int64, error) {
 	return strconv.ParseInt(lines[0], 2, 64)
 }
 
-func main() {
-	report := NewReport()
+type Solution struct {
+	Report *Report
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "binary diagnostic",
+		Year:    2021,
+		Problem: 3,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(198),
+				Expect2: solutions.Int(230),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(1997414),
+				Expect2: solutions.Int(1032597),
+			},
+		},
+	}
+}
 
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
-		report.AddLine(scanner.Text())
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Report = NewReport()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		for i, r := range line {
+			if r != '0' && r != '1' {
+				return util.Errorf(lineNo, i+1, string(r), "expected binary digit")
+			}
+		}
+		if lineNo > 1 && len(line) != len(s.Report.lines[0]) {
+			return util.Errorf(lineNo, 0, line, "expected %d digits", len(s.Report.lines[0]))
+		}
+		s.Report.AddLine(line)
 	}
-	if err := scanner.Err(); err != nil {
-		log.Fatalf("Scanner error: %v", err)
+	if err = scanner.Err(); err != nil {
+		return
 	}
+	if len(s.Report.lines) == 0 || len(s.Report.lines[0]) == 0 {
+		return fmt.Errorf("no lines in report")
+	}
+	return
+}
 
-	// Part 1
-	gamma, err := report.GammaRate()
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	gamma, err := s.Report.GammaRate()
 	if err != nil {
-		log.Fatalf("Error during string conversion: %v", err)
+		return
 	}
-	log.Printf("Gamma: %d", gamma)
 
-	epsilon, err := report.EpsilonRate()
+	epsilon, err := s.Report.EpsilonRate()
 	if err != nil {
-		log.Fatalf("Error during string conversion: %v", err)
+		return
 	}
-	log.Printf("Epsilon: %d", epsilon)
 
-	log.Printf("Gamma * Epsilon: %d", gamma*epsilon)
+	return solutions.Int64(gamma * epsilon), nil
+}
 
-	// Part 2
-	oxygen, err := report.OxygenGeneratorRating()
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	oxygen, err := s.Report.OxygenGeneratorRating()
 	if err != nil {
-		log.Fatalf("Error during string conversion: %v", err)
+		return
 	}
-	log.Printf("Oxygen Generator Rating: %d", oxygen)
 
-	co2, err := report.CO2ScrubberRating()
+	co2, err := s.Report.CO2ScrubberRating()
 	if err != nil {
-		log.Fatalf("Error during string conversion: %v", err)
+		return
 	}
-	log.Printf("CO2 Scrubber Rating: %d", co2)
 
-	log.Printf("Oxygen Generator * CO2 Scrubber: %d", oxygen*co2)
+	return solutions.Int64(oxygen * co2), nil
 }
@@ -0,0 +1,11 @@
+package giantsquid
+
+import (
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/solutions/solutionstest"
+)
+
+func FuzzParse(f *testing.F) {
+	solutionstest.FuzzParse(f, 2021, 4)
+}
@@ -0,0 +1,240 @@
+package giantsquid
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+type Board struct {
+	numbers         []int
+	hits            []bool
+	lastCallApplied int
+}
+
+func NewBoard() *Board {
+	return &Board{
+		numbers: make([]int, 0, 25),
+		hits:    make([]bool, 25),
+	}
+}
+
+func (b *Board) AddNumber(n int) {
+	b.numbers = append(b.numbers, n)
+}
+
+func (b *Board) Call(number int) {
+	for i, n := range b.numbers {
+		if n == number {
+			b.hits[i] = true
+			b.lastCallApplied = number
+		}
+	}
+}
+
+func (b *Board) LastCall() int {
+	return b.lastCallApplied
+}
+
+func (b *Board) HasVerticalWin() bool {
+	// Columns
+	for i := 0; i < 5; i++ {
+		hits := 0
+		// Rows
+		for j := i; j < 25; j += 5 {
+			if b.hits[j] {
+				hits++
+			}
+		}
+		if hits == 5 {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Board) HasHorizontalWin() bool {
+	// Rows
+	for i := 0; i < 25; i += 5 {
+		hits := 0
+		for _, hit := range b.hits[i : i+5] {
+			if hit {
+				hits++
+			}
+		}
+		if hits == 5 {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Board) HasWin() bool {
+	return b.HasHorizontalWin() || b.HasVerticalWin()
+}
+
+func (b *Board) UnmarkedSum() (sum int) {
+	for i, n := range b.numbers {
+		if !b.hits[i] {
+			sum += n
+		}
+	}
+	return
+}
+
+func (b *Board) ToString() string {
+	str := ""
+	for i := 0; i < 25; i += 5 {
+		for _, n := range b.numbers[i : i+5] {
+			str += fmt.Sprintf("%3d", n)
+		}
+		str += "\n"
+	}
+	return str
+}
+
+func firstToWin(boards []*Board, calls []int) *Board {
+	for _, call := range calls {
+		for _, board := range boards {
+			board.Call(call)
+			if board.HasWin() {
+				return board
+			}
+		}
+	}
+	return nil
+}
+
+func lastToWin(boards []*Board, calls []int) *Board {
+	var lastWinner *Board
+	for _, call := range calls {
+		for _, board := range boards {
+			// Skip won boards
+			if board.HasWin() {
+				continue
+			}
+			board.Call(call)
+			if board.HasWin() {
+				lastWinner = board
+			}
+		}
+	}
+	return lastWinner
+}
+
+type Solution struct {
+	Calls   []int
+	Numbers [][]int
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "giant squid",
+		Year:    2021,
+		Problem: 4,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(4512),
+				Expect2: solutions.Int(1924),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(2745),
+				Expect2: solutions.Int(6594),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Calls = make([]int, 0, 100)
+	s.Numbers = make([][]int, 0, 100)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if len(s.Calls) == 0 {
+			for _, call := range util.Split(line, ",") {
+				var i int
+				if i, err = util.Atoi(lineNo, call.Col, call.Text); err != nil {
+					return
+				}
+				s.Calls = append(s.Calls, i)
+			}
+			continue
+		}
+
+		if line == "" {
+			s.Numbers = append(s.Numbers, make([]int, 0, 25))
+			continue
+		}
+
+		if len(s.Numbers) == 0 {
+			return util.Errorf(lineNo, 0, line, "expected blank line before board")
+		}
+
+		last := len(s.Numbers) - 1
+		for _, n := range util.Fields(line) {
+			var i int
+			if i, err = util.Atoi(lineNo, n.Col, n.Text); err != nil {
+				return
+			}
+			s.Numbers[last] = append(s.Numbers[last], i)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+
+	for i, numbers := range s.Numbers {
+		if len(numbers) != 25 {
+			return fmt.Errorf("board %d has %d numbers, expected 25", i+1, len(numbers))
+		}
+	}
+	return
+}
+
+// Calls mark the boards, so each part gets a fresh set
+func (s *Solution) Boards() (boards []*Board) {
+	boards = make([]*Board, len(s.Numbers))
+	for i, numbers := range s.Numbers {
+		boards[i] = NewBoard()
+		for _, n := range numbers {
+			boards[i].AddNumber(n)
+		}
+	}
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	first := firstToWin(s.Boards(), s.Calls)
+	if first == nil {
+		return answer, fmt.Errorf("no winning board found")
+	}
+	return solutions.Int(first.LastCall() * first.UnmarkedSum()), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	last := lastToWin(s.Boards(), s.Calls)
+	if last == nil {
+		return answer, fmt.Errorf("no winning board found")
+	}
+	return solutions.Int(last.LastCall() * last.UnmarkedSum()), nil
+}
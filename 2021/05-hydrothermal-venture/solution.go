@@ -0,0 +1,148 @@
+package hydrothermalventure
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/geom"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+// FloorSize bounds the coordinates; the vents are all on a 1000x1000 floor
+const FloorSize = 1000
+
+type Point = geom.Point
+
+type Line struct {
+	A Point
+	B Point
+}
+
+func (l Line) IsVertical() bool {
+	return l.A.Y == l.B.Y
+}
+
+func (l Line) IsHorizontal() bool {
+	return l.A.X == l.B.X
+}
+
+func (l Line) IsStraight() bool {
+	return l.IsHorizontal() || l.IsVertical()
+}
+
+func (l Line) Points() []Point {
+	return geom.Line(l.A, l.B)
+}
+
+func overlappingPoints(lines []Line) (overlapping int) {
+	counts := make(map[Point]int)
+	for _, line := range lines {
+		for _, point := range line.Points() {
+			counts[point]++
+		}
+	}
+
+	for _, count := range counts {
+		if count > 1 {
+			overlapping++
+		}
+	}
+	return
+}
+
+type Solution struct {
+	Lines []Line
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "hydrothermal venture",
+		Year:    2021,
+		Problem: 5,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(5),
+				Expect2: solutions.Int(12),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(4655),
+				Expect2: solutions.Int(20500),
+			},
+		},
+	}
+}
+
+// line expects the following format:
+// 0,9 -> 5,9
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Lines = make([]Line, 0, 500)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		text := scanner.Text()
+		fields := util.Fields(text)
+		if len(fields) != 3 || fields[1].Text != "->" {
+			return util.Errorf(lineNo, 0, text, "expected x1,y1 -> x2,y2")
+		}
+
+		numbers := make([]int, 0, 4)
+		for _, field := range []util.Field{fields[0], fields[2]} {
+			values := util.Split(field.Text, ",")
+			if len(values) != 2 {
+				return util.Errorf(lineNo, field.Col, field.Text, "expected x,y")
+			}
+			for _, value := range values {
+				var i int
+				if i, err = util.Atoi(lineNo, field.Col+value.Col-1, value.Text); err != nil {
+					return
+				}
+				if i < 0 || i >= FloorSize {
+					return util.Errorf(lineNo, field.Col+value.Col-1, value.Text, "expected a coordinate from 0 to %d", FloorSize-1)
+				}
+				numbers = append(numbers, i)
+			}
+		}
+
+		s.Lines = append(s.Lines, Line{
+			A: Point{
+				X: numbers[0],
+				Y: numbers[1],
+			},
+			B: Point{
+				X: numbers[2],
+				Y: numbers[3],
+			},
+		})
+	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	straightLines := make([]Line, 0, len(s.Lines))
+	for _, line := range s.Lines {
+		if line.IsStraight() {
+			straightLines = append(straightLines, line)
+		}
+	}
+	return solutions.Int(overlappingPoints(straightLines)), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(overlappingPoints(s.Lines)), nil
+}
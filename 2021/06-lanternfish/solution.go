@@ -0,0 +1,136 @@
+package lanternfish
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+type Lanternfish struct {
+	Timer int
+}
+
+func NewLanternfish() *Lanternfish {
+	return &Lanternfish{8}
+}
+
+func (l *Lanternfish) GiveBirth() bool {
+	l.Timer--
+	if l.Timer == -1 {
+		l.Timer = 6
+		return true
+	}
+	return false
+}
+
+func (l *Lanternfish) String() string {
+	return fmt.Sprint(l.Timer)
+}
+
+func population(init []int, days int) int {
+	fish := make([]*Lanternfish, 0, 1000000)
+	for _, age := range init {
+		fish = append(fish, &Lanternfish{age})
+	}
+	for d := 0; d < days; d++ {
+		fishLen := len(fish)
+		for i := 0; i < fishLen; i++ {
+			if fish[i].GiveBirth() {
+				fish = append(fish, NewLanternfish())
+			}
+		}
+
+		// ages := make([]string, len(fish))
+		// for i := range ages {
+		// 	ages[i] = fish[i].String()
+		// }
+		// log.Printf("Day %d: %s", d, strings.Join(ages, ", "))
+	}
+	return len(fish)
+}
+
+func populationV2(init []int, days int) (total uint64) {
+	gestation := make([]uint64, 9)
+	for _, v := range init {
+		gestation[v]++
+	}
+	for day := 1; day <= days; day++ {
+		pregnant := gestation[0]
+		for i := 1; i < len(gestation); i++ {
+			gestation[i-1] = gestation[i]
+		}
+		gestation[6] += pregnant
+		gestation[8] = pregnant
+	}
+	for _, v := range gestation {
+		total += v
+	}
+	return
+}
+
+type Solution struct {
+	Ages []int
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "lanternfish",
+		Year:    2021,
+		Problem: 6,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(5934),
+				Expect2: solutions.Int(26984457539),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(374994),
+				Expect2: solutions.Int(1686252324092),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		values := util.Split(scanner.Text(), ",")
+		s.Ages = make([]int, 0, len(values))
+		for _, value := range values {
+			var i int
+			if i, err = util.Atoi(lineNo, value.Col, value.Text); err != nil {
+				return
+			}
+			if i < 0 || i > 8 {
+				return util.Errorf(lineNo, value.Col, value.Text, "expected timer from 0 to 8")
+			}
+			s.Ages = append(s.Ages, i)
+		}
+	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(int(populationV2(s.Ages, 80))), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(int(populationV2(s.Ages, 256))), nil
+}
@@ -0,0 +1,124 @@
+package whales
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+// Expects positions to be presorted
+// part 1 uses simple subtraction
+func minFuel(positions []int) (minFuel int) {
+	minFuel = math.MaxInt
+	max := positions[len(positions)-1]
+	for p := positions[0]; p < max; p++ {
+		fuel := 0
+		for _, pos := range positions {
+			cost := pos - p
+			if cost < 0 {
+				cost *= -1
+			}
+			fuel += cost
+		}
+		if fuel < minFuel {
+			minFuel = fuel
+		}
+	}
+	return
+}
+
+// Expects positions to be presorted
+// Part 2 uses a summation formula which pretty cleanly translates to
+// (n^2 + n) / 2
+func minFuelSummation(positions []int) (minFuel int) {
+	minFuel = math.MaxInt
+	max := positions[len(positions)-1]
+	for p := positions[0]; p < max; p++ {
+		fuel := 0
+		for _, pos := range positions {
+			n := pos - p
+			if n < 0 {
+				n *= -1
+			}
+			fuel += (n*n + n) / 2
+		}
+		if fuel < minFuel {
+			minFuel = fuel
+		}
+	}
+	return
+}
+
+type Solution struct {
+	Positions []int
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "whales",
+		Year:    2021,
+		Problem: 7,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(37),
+				Expect2: solutions.Int(168),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(333755),
+				Expect2: solutions.Int(94017638),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		values := util.Split(scanner.Text(), ",")
+		s.Positions = make([]int, 0, len(values))
+		for _, value := range values {
+			var i int
+			if i, err = util.Atoi(lineNo, value.Col, value.Text); err != nil {
+				return
+			}
+			s.Positions = append(s.Positions, i)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	if len(s.Positions) == 0 {
+		return fmt.Errorf("no positions found")
+	}
+
+	sort.Ints(s.Positions)
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(minFuel(s.Positions)), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(minFuelSummation(s.Positions)), nil
+}
@@ -1,15 +1,30 @@
-package main
+package sevensegment
 
 import (
 	"bufio"
-	"log"
+	"bytes"
+	_ "embed"
 	"math"
-	"os"
 	"sort"
 	"strings"
-	"time"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
+//go:embed test1.txt
+var test1Data []byte
+
+//go:embed test2.txt
+var test2Data []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
 var segments = []string{
 	"abcefg",  // 0
 	"cf",      // 1*
@@ -126,25 +141,80 @@ func decode(patterns []string, output []string) (num int) {
 	return
 }
 
-func main() {
-	start := time.Now()
-	totalInstances := 0
-	totalSum := 0
-
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
-		blocks := strings.Split(scanner.Text(), " | ")
-		patterns := strings.Fields(blocks[0])
-		output := strings.Fields(blocks[1])
-		totalInstances += instances1478(output)
-		totalSum += decode(patterns, output)
+type Entry struct {
+	Patterns []string
+	Output   []string
+}
+
+type Solution struct {
+	Entries []Entry
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "seven segment",
+		Year:    2021,
+		Problem: 8,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test 1",
+				Input:   test1Data,
+				Expect1: solutions.Int(0),
+				Expect2: solutions.Int(5353),
+			},
+			{
+				Name:    "Test 2",
+				Input:   test2Data,
+				Expect1: solutions.Int(26),
+				Expect2: solutions.Int(61229),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(264),
+				Expect2: solutions.Int(1063760),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Entries = make([]Entry, 0, 200)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		blocks := util.Split(line, " | ")
+		if len(blocks) != 2 {
+			return util.Errorf(lineNo, 0, line, "expected patterns | output")
+		}
+
+		entry := Entry{
+			Patterns: strings.Fields(blocks[0].Text),
+			Output:   strings.Fields(blocks[1].Text),
+		}
+		if len(entry.Patterns) != 10 {
+			return util.Errorf(lineNo, blocks[0].Col, blocks[0].Text, "expected 10 patterns")
+		}
+		if len(entry.Output) != 4 {
+			return util.Errorf(lineNo, blocks[1].Col, blocks[1].Text, "expected 4 output digits")
+		}
+		s.Entries = append(s.Entries, entry)
 	}
-	if err := scanner.Err(); err != nil {
-		log.Fatalf("Scanner error: %v", err)
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	count := 0
+	for _, entry := range s.Entries {
+		count += instances1478(entry.Output)
 	}
+	return solutions.Int(count), nil
+}
 
-	// Part 1
-	log.Printf("Instances of 1, 4, 7, and 8: %d", totalInstances)
-	log.Printf("Total Sum: %d", totalSum)
-	log.Printf("Took %s", time.Since(start))
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	sum := 0
+	for _, entry := range s.Entries {
+		sum += decode(entry.Patterns, entry.Output)
+	}
+	return solutions.Int(sum), nil
 }
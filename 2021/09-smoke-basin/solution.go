@@ -0,0 +1,102 @@
+package smokebasin
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util/grid"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+// A low point is lower than every point around it
+func lowPoints(heights *grid.Grid[int]) (low []grid.Point) {
+	for _, p := range heights.Points() {
+		lowest := true
+		for _, q := range heights.Neighbours4(p) {
+			lowest = lowest && heights.At(q) > heights.At(p)
+		}
+		if lowest {
+			low = append(low, p)
+		}
+	}
+	return
+}
+
+func lowPointRisk(heights *grid.Grid[int]) (risk int) {
+	for _, p := range lowPoints(heights) {
+		risk += heights.At(p) + 1
+	}
+	return
+}
+
+// Basins are bounded by the highest points, 9, and the edges of the map
+func basinSizes(heights *grid.Grid[int]) (sizes []int) {
+	for _, basin := range heights.Regions(func(v int) bool { return v != 9 }) {
+		sizes = append(sizes, len(basin))
+	}
+	return
+}
+
+func largestBasinProduct(basins []int, top int) (product int, err error) {
+	if len(basins) < top {
+		return 0, fmt.Errorf("found %d basins, need at least %d", len(basins), top)
+	}
+
+	product = 1
+	sort.Ints(basins)
+	for _, v := range basins[len(basins)-top:] {
+		product *= v
+	}
+	return
+}
+
+type Solution struct {
+	Heights *grid.Grid[int]
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "smoke basin",
+		Year:    2021,
+		Problem: 9,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(15),
+				Expect2: solutions.Int(1134),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(562),
+				Expect2: solutions.Int(1076922),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Heights, err = grid.ParseDigits(data)
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(lowPointRisk(s.Heights)), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	product, err := largestBasinProduct(basinSizes(s.Heights), 3)
+	return solutions.Int(product), err
+}
@@ -1,13 +1,26 @@
-package main
+package syntaxscoring
 
 import (
 	"bufio"
-	"log"
-	"os"
+	"bytes"
+	_ "embed"
+	"fmt"
 	"sort"
 	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
 )
 
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
 type Subsystem struct {
 	lines    []string
 	replacer *strings.Replacer
@@ -47,16 +60,19 @@ func (s *Subsystem) CorruptedScore() (score int) {
 	return
 }
 
-func (s *Subsystem) IncompleteScore() (score int) {
+func (s *Subsystem) IncompleteScore() (score int, err error) {
 	scores := make([]int, 0, len(s.lines))
 	for _, reduced := range s.reduced() {
 		if incomplete, lineScore := s.isIncomplete(reduced); incomplete {
 			scores = append(scores, lineScore)
 		}
 	}
+	if len(scores) == 0 {
+		return 0, fmt.Errorf("no incomplete lines")
+	}
 	sort.Ints(scores)
 	middle := len(scores) / 2
-	return scores[middle]
+	return scores[middle], nil
 }
 
 // A corrupted line is one where a chunk closes with the wrong character
@@ -106,15 +122,46 @@ func (s *Subsystem) reduceLine(in string) (out string) {
 	return s.reduceLine(out)
 }
 
-func main() {
-	subsystem := NewSubsystem()
-	scanner := bufio.NewScanner(os.Stdin)
+type Solution struct {
+	Subsystem *Subsystem
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "syntax scoring",
+		Year:    2021,
+		Problem: 10,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(26397),
+				Expect2: solutions.Int(288957),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(339411),
+				Expect2: solutions.Int(2289754624),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Subsystem = NewSubsystem()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
 	for scanner.Scan() {
-		subsystem.AddLine(scanner.Text())
+		s.Subsystem.AddLine(scanner.Text())
 	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(s.Subsystem.CorruptedScore()), nil
+}
 
-	// Part 1
-	log.Printf("Corrupted lines score: %d", subsystem.CorruptedScore())
-	// Part 2
-	log.Printf("Incomplete line score: %d", subsystem.IncompleteScore())
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	score, err := s.Subsystem.IncompleteScore()
+	return solutions.Int(score), err
 }
@@ -0,0 +1,177 @@
+package dumbooctopus
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util/grid"
+)
+
+//go:embed test1.txt
+var test1Data []byte
+
+//go:embed test2.txt
+var test2Data []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+type Cavern struct {
+	*grid.Grid[Octopus]
+}
+
+type Octopus struct {
+	Level   int
+	Flashed bool
+}
+
+func (o *Octopus) Reset() {
+	o.Level = 0
+	o.Flashed = false
+}
+
+// Steps change the energy levels, so each part builds its own cavern
+func NewCavern(levels *grid.Grid[int]) *Cavern {
+	c := &Cavern{grid.New[Octopus](levels.Width, levels.Height)}
+	for i, level := range levels.Cells {
+		c.Cells[i].Level = level
+	}
+	return c
+}
+
+func (c *Cavern) AllFlashing() bool {
+	for _, octopus := range c.Cells {
+		if !octopus.Flashed {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Cavern) Flashes(steps int) (flashes int) {
+	for i := 0; i < steps; i++ {
+		flashes += c.Step()
+	}
+	return
+}
+
+func (c *Cavern) SimultaneousFlash() (step int) {
+	for i := 0; i < 10000; i++ {
+		c.Step()
+		if c.AllFlashing() {
+			step = i + 1
+			return
+		}
+	}
+	return
+}
+
+func (c *Cavern) Step() (flashes int) {
+	// 0. Unset flash flag
+	// 1. Increment the energy level by one
+	for i := range c.Cells {
+		c.Cells[i].Flashed = false
+		c.Cells[i].Level++
+	}
+
+	// 2. Any octopus with an energy level greater than 9 flashes
+	for _, p := range c.Points() {
+		c.flash(p)
+	}
+
+	// 3. Count flashes and reset to zero
+	for i := range c.Cells {
+		if c.Cells[i].Flashed {
+			flashes++
+			c.Cells[i].Level = 0
+		}
+	}
+
+	return
+}
+
+func (c *Cavern) String() string {
+	var builder strings.Builder
+	for y := 0; y < c.Height; y++ {
+		for _, octopus := range c.Row(y) {
+			format := "%d"
+			if octopus.Flashed {
+				format = "\033[1;31m%d\033[0m"
+			}
+			fmt.Fprintf(&builder, format, octopus.Level)
+		}
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
+
+func (c *Cavern) flash(p grid.Point) {
+	octopus := c.At(p)
+	if octopus.Level < 10 || octopus.Flashed {
+		return
+	}
+	octopus.Flashed = true
+	c.Set(p, octopus)
+	for _, q := range c.Neighbours8(p) {
+		neighbour := c.At(q)
+		neighbour.Level++
+		c.Set(q, neighbour)
+		c.flash(q)
+	}
+}
+
+type Solution struct {
+	Levels *grid.Grid[int]
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "dumbo octopus",
+		Year:    2021,
+		Problem: 11,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test 1",
+				Input:   test1Data,
+				Expect1: solutions.Int(259),
+				Expect2: solutions.Int(6),
+			},
+			{
+				Name:    "Test 2",
+				Input:   test2Data,
+				Expect1: solutions.Int(1656),
+				Expect2: solutions.Int(195),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(1741),
+				Expect2: solutions.Int(440),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Levels, err = grid.ParseDigits(data)
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(NewCavern(s.Levels).Flashes(100)), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	step := NewCavern(s.Levels).SimultaneousFlash()
+	if step == 0 {
+		err = fmt.Errorf("octopuses never flashed simultaneously")
+		return
+	}
+	return solutions.Int(step), nil
+}
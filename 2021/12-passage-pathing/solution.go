@@ -1,13 +1,29 @@
-package main
+package passagepathing
 
 import (
-	"fmt"
-	"io"
-	"log"
-	"os"
+	_ "embed"
 	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
+//go:embed test1.txt
+var test1Data []byte
+
+//go:embed test2.txt
+var test2Data []byte
+
+//go:embed test3.txt
+var test3Data []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
 // Very helpful writeup, even if I still don't understand DFS
 // https://skarlso.github.io/2021/12/17/aoc-day12-updated/
 
@@ -105,25 +121,67 @@ func part2(caves map[string][]string) (count int) {
 	return
 }
 
-func main() {
-	input, err := io.ReadAll(os.Stdin)
-	if err != nil {
-		log.Fatalf("error reading input: %v", err)
+type Solution struct {
+	Caves map[string][]string
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "passage pathing",
+		Year:    2021,
+		Problem: 12,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test 1",
+				Input:   test1Data,
+				Expect1: solutions.Int(10),
+				Expect2: solutions.Int(36),
+			},
+			{
+				Name:    "Test 2",
+				Input:   test2Data,
+				Expect1: solutions.Int(19),
+				Expect2: solutions.Int(103),
+			},
+			{
+				Name:    "Test 3",
+				Input:   test3Data,
+				Expect1: solutions.Int(226),
+				Expect2: solutions.Int(3509),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(4707),
+				Expect2: solutions.Int(130493),
+			},
+		},
 	}
+}
 
-	lines := strings.Split(strings.TrimSpace(string(input)), "\n")
+func (s *Solution) Parse(data []byte) (err error) {
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
 	paths := make([][2]string, 0, len(lines))
-	for _, line := range lines {
+	for i, line := range lines {
 		parts := strings.SplitN(line, "-", 2)
+		if len(parts) != 2 {
+			return util.Errorf(i+1, 0, line, "expected cave-cave")
+		}
 		paths = append(paths, [2]string{parts[0], parts[1]})
 	}
 
-	caves := make(map[string][]string)
+	s.Caves = make(map[string][]string)
 	for _, path := range paths {
-		caves[path[0]] = append(caves[path[0]], path[1])
-		caves[path[1]] = append(caves[path[1]], path[0])
+		s.Caves[path[0]] = append(s.Caves[path[0]], path[1])
+		s.Caves[path[1]] = append(s.Caves[path[1]], path[0])
 	}
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Caves)), nil
+}
 
-	fmt.Printf("Part 1: %d\n", part1(caves))
-	fmt.Printf("Part 2: %d\n", part2(caves))
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(part2(s.Caves)), nil
 }
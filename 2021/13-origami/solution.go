@@ -0,0 +1,270 @@
+package origami
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+// Part 2 draws a square for the test data and spells UFRZKAUZ for the input
+const (
+	testRender = `
+#####
+#...#
+#...#
+#...#
+#####
+`
+	inputRender = `
+#..#.####.###..####.#..#..##..#..#.####
+#..#.#....#..#....#.#.#..#..#.#..#....#
+#..#.###..#..#...#..##...#..#.#..#...#.
+#..#.#....###...#...#.#..####.#..#..#..
+#..#.#....#.#..#....#.#..#..#.#..#.#...
+.##..#....#..#.####.#..#.#..#..##..####
+`
+)
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+const (
+	XAxis = 'x'
+	YAxis = 'y'
+)
+
+// MaxDrawn bounds the width and height of the folded paper part 2 draws
+const MaxDrawn = 1000
+
+type Fold struct {
+	Axis  byte
+	Value int
+}
+
+type Grid struct {
+	Points map[[2]int]bool
+	Folds  []Fold
+}
+
+func NewGrid() *Grid {
+	return &Grid{
+		Points: make(map[[2]int]bool),
+		Folds:  make([]Fold, 0, 10),
+	}
+}
+
+func (g *Grid) AddFold(axis byte, value int) {
+	g.Folds = append(g.Folds, Fold{
+		Axis:  axis,
+		Value: value,
+	})
+}
+
+func (src *Grid) Copy() (dst *Grid) {
+	dst = &Grid{
+		Points: make(map[[2]int]bool),
+		Folds:  make([]Fold, len(src.Folds)),
+	}
+	copy(dst.Folds, src.Folds)
+	for k, v := range src.Points {
+		dst.Points[k] = v
+	}
+	return
+}
+
+func (g *Grid) SetPoint(x, y int) {
+	g.Points[[2]int{x, y}] = true
+}
+
+func (g Grid) Size() (width, height int) {
+	for coords := range g.Points {
+		if coords[0] > width {
+			width = coords[0]
+		}
+		if coords[1] > height {
+			height = coords[1]
+		}
+	}
+	width++
+	height++
+	return
+}
+
+func (g *Grid) Fold(times int) {
+	if times == -1 {
+		times = len(g.Folds)
+	}
+	for _, fold := range g.Folds[:times] {
+		for point := range g.Points {
+			if fold.Axis == XAxis && point[0] > fold.Value {
+				delete(g.Points, point)
+				g.Points[[2]int{fold.Value*2 - point[0], point[1]}] = true
+			}
+			if fold.Axis == YAxis && point[1] > fold.Value {
+				delete(g.Points, point)
+				g.Points[[2]int{point[0], fold.Value*2 - point[1]}] = true
+			}
+		}
+	}
+}
+
+func (g Grid) String() (s string) {
+	width, height := g.Size()
+	grid := make([][]byte, height)
+	for i := range grid {
+		grid[i] = bytes.Repeat([]byte{'.'}, width)
+	}
+	for coords, active := range g.Points {
+		// 	s += fmt.Sprintf("(%3d, %3d)\n", coords[0], coords[1])
+		var ch byte = '#'
+		if !active {
+			ch = '%'
+		}
+		grid[coords[1]][coords[0]] = ch
+	}
+	for _, row := range grid {
+		s += string(row) + "\n"
+	}
+	return
+}
+
+func part1(grid *Grid) (count int) {
+	grid.Fold(1)
+	return len(grid.Points)
+}
+
+func part2(grid *Grid) string {
+	grid.Fold(-1)
+	return grid.String()
+}
+
+type Solution struct {
+	Grid *Grid
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "origami",
+		Year:    2021,
+		Problem: 13,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(17),
+				Expect2: solutions.Render(testRender),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(735),
+				Expect2: solutions.Render(inputRender),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Grid = NewGrid()
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if strings.ContainsRune(line, ',') {
+			var x, y int
+			x1, y1, _ := strings.Cut(line, ",")
+			if x, err = util.Atoi(lineNo, 1, x1); err != nil {
+				return
+			}
+			if y, err = util.Atoi(lineNo, len(x1)+2, y1); err != nil {
+				return
+			}
+			if x < 0 || y < 0 {
+				return util.Errorf(lineNo, 0, line, "expected non-negative coordinates")
+			}
+			s.Grid.SetPoint(x, y)
+			continue
+		}
+		if strings.HasPrefix(line, "fold") {
+			fields := util.Fields(line)
+			if len(fields) != 3 {
+				return util.Errorf(lineNo, 0, line, "expected fold along axis=value")
+			}
+			axis, v, ok := strings.Cut(fields[2].Text, "=")
+			if !ok || (axis != string(XAxis) && axis != string(YAxis)) {
+				return util.Errorf(lineNo, fields[2].Col, fields[2].Text, "expected x= or y=")
+			}
+			var value int
+			if value, err = util.Atoi(lineNo, fields[2].Col+len(axis)+1, v); err != nil {
+				return
+			}
+			s.Grid.AddFold(axis[0], value)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	if len(s.Grid.Folds) == 0 {
+		return fmt.Errorf("no folds found")
+	}
+	return s.Grid.checkFolds()
+}
+
+// checkFolds makes sure no fold reaches a point more than its value past the
+// line, which would land it off the paper, and that the folded paper is small
+// enough to draw
+func (g Grid) checkFolds() error {
+	points := make([][2]int, 0, len(g.Points))
+	for point := range g.Points {
+		points = append(points, point)
+	}
+	for _, fold := range g.Folds {
+		axis := 0
+		if fold.Axis == YAxis {
+			axis = 1
+		}
+		for i := range points {
+			if points[i][axis] <= fold.Value {
+				continue
+			}
+			if points[i][axis] = fold.Value*2 - points[i][axis]; points[i][axis] < 0 {
+				return fmt.Errorf("fold along %c=%d folds points off the paper", fold.Axis, fold.Value)
+			}
+		}
+	}
+
+	var width, height int
+	for _, point := range points {
+		if point[0] >= width {
+			width = point[0] + 1
+		}
+		if point[1] >= height {
+			height = point[1] + 1
+		}
+	}
+	if width > MaxDrawn || height > MaxDrawn {
+		return fmt.Errorf("folded paper is %dx%d, too big to draw", width, height)
+	}
+	return nil
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Grid.Copy())), nil
+}
+
+// The folded paper spells out eight capital letters
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Render(part2(s.Grid.Copy())), nil
+}
@@ -1,13 +1,26 @@
-package main
+package polymerization
 
 import (
 	"bufio"
-	"fmt"
+	"bytes"
+	_ "embed"
 	"math"
-	"os"
 	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
 type Polymer struct {
 	Template string
 	Pairs    map[string]byte
@@ -142,22 +155,68 @@ func part2(polymer Polymer) (result int) {
 	return max - min
 }
 
-func main() {
-	polymer := Polymer{
+type Solution struct {
+	Polymer Polymer
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "polymerization",
+		Year:    2021,
+		Problem: 14,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(1588),
+				Expect2: solutions.Int(2188189693529),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(3259),
+				Expect2: solutions.Int(3459174981021),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Polymer = Polymer{
 		Pairs: make(map[string]byte),
 	}
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	templateLine := 0
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
-		if polymer.Template == "" {
-			polymer.Template = line
+		if s.Polymer.Template == "" {
+			s.Polymer.Template, templateLine = line, lineNo
 		}
 		if strings.Contains(line, "->") {
 			fields := strings.Fields(line)
-			polymer.Pairs[fields[0]] = fields[2][0]
+			if len(fields) != 3 || len(fields[0]) != 2 || len(fields[2]) != 1 {
+				return util.Errorf(lineNo, 0, line, "expected pair insertion rule AB -> C")
+			}
+			s.Polymer.Pairs[fields[0]] = fields[2][0]
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+
+	// Every pair in the template must have a rule or Step inserts a zero byte
+	for i := 0; i < len(s.Polymer.Template)-1; i++ {
+		if _, ok := s.Polymer.Pairs[s.Polymer.Template[i:i+2]]; !ok {
+			return util.Errorf(templateLine, i+1, s.Polymer.Template[i:i+2], "no rule for pair")
 		}
 	}
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Polymer)), nil
+}
 
-	fmt.Printf("Part 1: %d\n", part1(polymer))
-	fmt.Printf("Part 2: %d\n", part2(polymer))
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(part2(s.Polymer)), nil
 }
@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/geom"
+	"github.com/jbaikge/advent-of-code/util/grid"
+	"github.com/jbaikge/advent-of-code/util/search"
 )
 
 //go:embed *.txt
@@ -13,22 +17,76 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
-type Solution struct{}
+func init() {
+	solutions.Register(solutions.Adapt(2021, 15, "chiton", new(Solution)))
+}
+
+// lowestRisk is the least total risk entering each cell on the way from the
+// top left to the bottom right; the starting cell is never entered
+func lowestRisk(cave *grid.Grid[int]) (risk int, err error) {
+	if len(cave.Cells) == 0 {
+		return 0, fmt.Errorf("empty cave")
+	}
+	start := geom.Point{X: 0, Y: 0}
+	end := geom.Point{X: cave.Width - 1, Y: cave.Height - 1}
+	edges := func(p geom.Point) []search.Edge[geom.Point] {
+		edges := make([]search.Edge[geom.Point], 0, 4)
+		for _, q := range cave.Neighbours4(p) {
+			edges = append(edges, search.Edge[geom.Point]{To: q, Cost: cave.At(q)})
+		}
+		return edges
+	}
+	path, ok := search.Dijkstra(start, search.Is(end), edges)
+	if !ok {
+		return 0, fmt.Errorf("no path from %s to %s", start, end)
+	}
+	return path.Cost, nil
+}
+
+// expand tiles the cave five times across and down. Each tile right or down
+// adds one to every risk, wrapping from 9 back around to 1.
+func expand(cave *grid.Grid[int]) *grid.Grid[int] {
+	const tiles = 5
+	full := grid.New[int](cave.Width*tiles, cave.Height*tiles)
+	for _, p := range full.Points() {
+		risk := cave.At(geom.Point{X: p.X % cave.Width, Y: p.Y % cave.Height})
+		risk += p.X/cave.Width + p.Y/cave.Height
+		full.Set(p, (risk-1)%9+1)
+	}
+	return full
+}
+
+type Solution struct {
+	Cave *grid.Grid[int]
+}
 
 func (s Solution) Files() embed.FS {
 	return Files
 }
 
 func (s *Solution) Parse(r io.Reader) (err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	s.Cave, err = grid.ParseDigits(data)
 	return
 }
 
 func (s Solution) Part1(w io.Writer) (err error) {
-	fmt.Fprintf(w, "Part 1: %d\n", 0)
+	risk, err := lowestRisk(s.Cave)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "Part 1: %d\n", risk)
 	return
 }
 
 func (s Solution) Part2(w io.Writer) (err error) {
-	fmt.Fprintf(w, "Part 2: %d\n", 0)
+	risk, err := lowestRisk(expand(s.Cave))
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "Part 2: %d\n", risk)
 	return
 }
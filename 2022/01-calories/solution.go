@@ -0,0 +1,83 @@
+package calories
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util/parse"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+const Part2TopElves = 3
+
+type Solution struct {
+	// Sorted with the most calories first
+	ElfCalories []int
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "calories",
+		Year:    2022,
+		Problem: 1,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(24000),
+				Expect2: solutions.Int(45000),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(70698),
+				Expect2: solutions.Int(206643),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	elves := parse.Paragraphs(parse.Lines(data))
+	s.ElfCalories = make([]int, len(elves))
+	for elf, lines := range elves {
+		for _, line := range lines {
+			var calories int
+			if calories, err = line.Int(); err != nil {
+				return
+			}
+			s.ElfCalories[elf] += calories
+		}
+	}
+	if len(s.ElfCalories) < Part2TopElves {
+		return fmt.Errorf("found %d elves, need at least %d", len(s.ElfCalories), Part2TopElves)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(s.ElfCalories)))
+	return
+}
+
+// Total calories held by top elf
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(s.ElfCalories[0]), nil
+}
+
+// Total calories held by top 3 elves
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	total := 0
+	for i := 0; i < Part2TopElves; i++ {
+		total += s.ElfCalories[i]
+	}
+	return solutions.Int(total), nil
+}
@@ -1,11 +1,24 @@
-package main
+package rockpaperscissors
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"bytes"
+	_ "embed"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
 const (
 	ScoreLose     = 0
 	ScoreDraw     = 3
@@ -81,14 +94,49 @@ func part2(rounds [][2]byte) (total int) {
 	return
 }
 
-func main() {
-	rounds := make([][2]byte, 0, 2500)
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
+type Solution struct {
+	Rounds [][2]byte
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "rock paper scissors",
+		Year:    2022,
+		Problem: 2,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(15),
+				Expect2: solutions.Int(12),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(13526),
+				Expect2: solutions.Int(14204),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Rounds = make([][2]byte, 0, 2500)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
-		rounds = append(rounds, [2]byte{line[0], line[2]})
+		if len(line) != 3 || line[0] < 'A' || line[0] > 'C' || line[2] < 'X' || line[2] > 'Z' {
+			return util.Errorf(lineNo, 0, line, "expected A, B or C then X, Y or Z")
+		}
+		s.Rounds = append(s.Rounds, [2]byte{line[0], line[2]})
 	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Rounds)), nil
+}
 
-	fmt.Printf("Part 1: %d\n", part1(rounds))
-	fmt.Printf("Part 2: %d\n", part2(rounds))
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(part2(s.Rounds)), nil
 }
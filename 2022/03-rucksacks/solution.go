@@ -0,0 +1,138 @@
+package rucksacks
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+var priorities map[rune]int
+
+func init() {
+	priorities = make(map[rune]int)
+	for i, j := 'a', 'A'; i <= 'z'; i, j = i+1, j+1 {
+		priorities[i] = int(i-'a') + 1
+		priorities[j] = int(j-'A') + 27
+	}
+}
+
+func sackCommon(a, b string) (common rune, err error) {
+	for _, aChar := range a {
+		for _, bChar := range b {
+			if aChar == bChar {
+				return aChar, nil
+			}
+		}
+	}
+	err = fmt.Errorf("no common item found")
+	return
+}
+
+// Find item common to both rucksacks and total the item's priority
+func part1(lines []string) (total int, err error) {
+	for _, line := range lines {
+		midpoint := len(line) / 2
+		sack1, sack2 := line[:midpoint], line[midpoint:]
+		common, err := sackCommon(sack1, sack2)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", line, err)
+		}
+		priority := priorities[common]
+		total += priority
+	}
+	return
+}
+
+func groupCommmon(a, b, c string) (common rune, err error) {
+	for _, aChar := range a {
+		for _, bChar := range b {
+			if aChar != bChar {
+				continue
+			}
+			for _, cChar := range c {
+				if aChar == cChar {
+					return aChar, nil
+				}
+			}
+		}
+	}
+	err = fmt.Errorf("no common item found")
+	return
+}
+
+func part2(lines []string) (total int, err error) {
+	for i := 0; i < len(lines); i += 3 {
+		group := lines[i : i+3]
+		common, err := groupCommmon(group[0], group[1], group[2])
+		if err != nil {
+			return 0, fmt.Errorf("group starting on line %d: %w", i+1, err)
+		}
+		priority := priorities[common]
+		total += priority
+	}
+	return
+}
+
+type Solution struct {
+	Lines []string
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "rucksacks",
+		Year:    2022,
+		Problem: 3,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(157),
+				Expect2: solutions.Int(70),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(7903),
+				Expect2: solutions.Int(2548),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Lines = make([]string, 0, 300)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		s.Lines = append(s.Lines, scanner.Text())
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	if len(s.Lines)%3 != 0 {
+		return fmt.Errorf("%d rucksacks cannot be split into groups of three", len(s.Lines))
+	}
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	total, err := part1(s.Lines)
+	return solutions.Int(total), err
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	total, err := part2(s.Lines)
+	return solutions.Int(total), err
+}
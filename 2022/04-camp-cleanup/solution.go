@@ -0,0 +1,116 @@
+package campcleanup
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/interval"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+type Pair struct {
+	A interval.Interval
+	B interval.Interval
+}
+
+func NewPair(a, b, c, d int) Pair {
+	return Pair{
+		A: interval.New(a, b),
+		B: interval.New(c, d),
+	}
+}
+
+func (p Pair) FullOverlap() bool {
+	return p.A.Covers(p.B) || p.B.Covers(p.A)
+}
+
+func (p Pair) PartialOverlap() bool {
+	return p.A.Overlaps(p.B)
+}
+
+func part1(pairs []Pair) (count int) {
+	for _, pair := range pairs {
+		if pair.FullOverlap() {
+			count++
+		}
+	}
+	return
+}
+
+func part2(pairs []Pair) (count int) {
+	for _, pair := range pairs {
+		if pair.PartialOverlap() {
+			count++
+		}
+	}
+	return
+}
+
+type Solution struct {
+	Pairs []Pair
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "camp cleanup",
+		Year:    2022,
+		Problem: 4,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(2),
+				Expect2: solutions.Int(4),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(496),
+				Expect2: solutions.Int(847),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Pairs = make([]Pair, 0, 1000)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		// Format: a-b,c-d
+		fields := util.FieldsFunc(line, func(r rune) bool {
+			return r == '-' || r == ','
+		})
+		if len(fields) != 4 {
+			return util.Errorf(lineNo, 0, line, "expected a-b,c-d")
+		}
+		n := make([]int, len(fields))
+		for i, f := range fields {
+			if n[i], err = util.Atoi(lineNo, f.Col, f.Text); err != nil {
+				return
+			}
+		}
+		s.Pairs = append(s.Pairs, NewPair(n[0], n[1], n[2], n[3]))
+	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Pairs)), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(part2(s.Pairs)), nil
+}
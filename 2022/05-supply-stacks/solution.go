@@ -0,0 +1,286 @@
+package supplystacks
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+type Stack struct {
+	Crates []string
+}
+
+func (s *Stack) Pop() (crate string, err error) {
+	length := len(s.Crates)
+	if length == 0 {
+		err = fmt.Errorf("empty stack of crates")
+		return
+	}
+	crate = s.Crates[length-1]
+	s.Crates = s.Crates[:length-1]
+	return
+}
+
+func (s *Stack) Push(crate string) {
+	s.Crates = append(s.Crates, crate)
+}
+
+func (s *Stack) Top() string {
+	if len(s.Crates) == 0 {
+		return ""
+	}
+	return s.Crates[len(s.Crates)-1]
+}
+
+type Move struct {
+	Quantity int
+	From     int
+	To       int
+}
+
+// line expects the following format:
+// move 1 from 2 to 1
+func NewMove(line string) (m Move, err error) {
+	fields := util.Fields(line)
+	if len(fields) != 6 {
+		err = util.Errorf(0, 0, line, "expected move N from A to B")
+		return
+	}
+	if m.Quantity, err = util.Atoi(0, fields[1].Col, fields[1].Text); err != nil {
+		return
+	}
+	if m.From, err = util.Atoi(0, fields[3].Col, fields[3].Text); err != nil {
+		return
+	}
+	m.To, err = util.Atoi(0, fields[5].Col, fields[5].Text)
+	return
+}
+
+type Ship struct {
+	Stacks      []Stack
+	Moves       []Move
+	CrateBuffer []string
+}
+
+func NewShip() *Ship {
+	return &Ship{
+		Moves:       make([]Move, 0, 500),
+		CrateBuffer: make([]string, 0, 8),
+	}
+}
+
+func (s *Ship) AddMove(line string) (err error) {
+	move, err := NewMove(line)
+	if err != nil {
+		return
+	}
+	if move.From < 1 || move.From > len(s.Stacks) || move.To < 1 || move.To > len(s.Stacks) {
+		return util.Errorf(0, 0, line, "move references a missing stack")
+	}
+	s.Moves = append(s.Moves, move)
+	return
+}
+
+// Adds a line from the input into a buffer, trimming the space on the right
+// for consistency since my editor is also trimming the space on the right.
+func (s *Ship) BufferCrates(line string) {
+	s.CrateBuffer = append(s.CrateBuffer, strings.TrimRight(line, " "))
+}
+
+// Make copies of the ship in order to operate the crane differently between
+// parts 1 & 2
+func (src Ship) Copy() (dst Ship) {
+	dst.Moves = make([]Move, len(src.Moves))
+	copy(dst.Moves, src.Moves)
+	dst.Stacks = make([]Stack, len(src.Stacks))
+	copy(dst.Stacks, src.Stacks)
+	for i, stack := range src.Stacks {
+		dst.Stacks[i].Crates = make([]string, len(stack.Crates))
+		copy(dst.Stacks[i].Crates, stack.Crates)
+	}
+	return
+}
+
+// Parses the line with 1 2 3 ...
+func (s *Ship) InitStacks(line string) (err error) {
+	fields := util.Fields(line)
+	for i, field := range fields {
+		if field.Text != strconv.Itoa(i+1) {
+			return util.Errorf(0, field.Col, field.Text, "expected stack label %d", i+1)
+		}
+	}
+	s.Stacks = make([]Stack, len(fields))
+	return s.parseCrateBuffer()
+}
+
+// CrateMover 9000 only moves one crate at a time
+func (s *Ship) MoveCrates9000() (err error) {
+	for _, move := range s.Moves {
+		from := move.From - 1
+		to := move.To - 1
+		for i := 0; i < move.Quantity; i++ {
+			var crate string
+			if crate, err = s.Stacks[from].Pop(); err != nil {
+				return
+			}
+			s.Stacks[to].Push(crate)
+		}
+	}
+	return
+}
+
+// CrateMover 9001 can move entire stacks of crates at a time
+func (s *Ship) MoveCrates9001() (err error) {
+	for _, move := range s.Moves {
+		from := move.From - 1
+		to := move.To - 1
+		buffer := make([]string, move.Quantity)
+		for i := move.Quantity - 1; i >= 0; i-- {
+			if buffer[i], err = s.Stacks[from].Pop(); err != nil {
+				return
+			}
+		}
+		for _, crate := range buffer {
+			s.Stacks[to].Push(crate)
+		}
+	}
+	return
+}
+
+func (s Ship) String() (str string) {
+	maxLen := 0
+	for _, stack := range s.Stacks {
+		if length := len(stack.Crates); length > maxLen {
+			maxLen = length
+		}
+	}
+
+	for i := 0; i < maxLen; i++ {
+		crates := make([]string, 0, len(s.Stacks))
+		for _, stack := range s.Stacks {
+			if i+1 > len(stack.Crates) {
+				crates = append(crates, "   ")
+				continue
+			}
+			crates = append(crates, stack.Crates[i])
+		}
+		str = strings.Join(crates, " ") + "\n" + str
+	}
+	return
+}
+
+func (s Ship) Top() (top string) {
+	for _, stack := range s.Stacks {
+		if crate := stack.Top(); len(crate) == 3 {
+			top += crate[1:2]
+		}
+	}
+	return
+}
+
+func (s *Ship) parseCrateBuffer() (err error) {
+	for i := len(s.CrateBuffer) - 1; i >= 0; i-- {
+		line := s.CrateBuffer[i]
+		// Put placeholders in between stacks
+		line = strings.ReplaceAll(line, "]     ", "] --- ")
+		// Put placeholders along the leading edge
+		line = strings.ReplaceAll(line, "    ", "--- ")
+		crates := strings.Fields(line)
+		if len(crates) > len(s.Stacks) {
+			// The crate drawing opens the input, so its lines come first
+			return util.Errorf(i+1, 0, s.CrateBuffer[i], "more crates than stacks")
+		}
+		for i, crate := range crates {
+			if crate == "---" {
+				continue
+			}
+			s.Stacks[i].Push(crate)
+		}
+	}
+	return
+}
+
+type Solution struct {
+	Ship *Ship
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "supply stacks",
+		Year:    2022,
+		Problem: 5,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.String("CMZ"),
+				Expect2: solutions.String("MCD"),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.String("VJSFHWGFT"),
+				Expect2: solutions.String("LCTQFBVZV"),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Ship = NewShip()
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if strings.ContainsRune(line, '[') {
+			s.Ship.BufferCrates(line)
+			continue
+		}
+		if strings.HasPrefix(line, " 1") {
+			if err = s.Ship.InitStacks(line); err != nil {
+				return util.AtLine(err, lineNo)
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "move") {
+			if err = s.Ship.AddMove(line); err != nil {
+				return util.AtLine(err, lineNo)
+			}
+			continue
+		}
+	}
+	return scanner.Err()
+}
+
+// The answer is the crate on top of each stack, e.g. CMZ
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	ship := s.Ship.Copy()
+	if err = ship.MoveCrates9000(); err != nil {
+		return
+	}
+	return solutions.String(ship.Top()), nil
+}
+
+// Same as part 1, but with the CrateMover 9001
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	ship := s.Ship.Copy()
+	if err = ship.MoveCrates9001(); err != nil {
+		return
+	}
+	return solutions.String(ship.Top()), nil
+}
@@ -0,0 +1,139 @@
+package tuningtrouble
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+//go:embed test1.txt
+var test1Data []byte
+
+//go:embed test2.txt
+var test2Data []byte
+
+//go:embed test3.txt
+var test3Data []byte
+
+//go:embed test4.txt
+var test4Data []byte
+
+//go:embed test5.txt
+var test5Data []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+func part1(line string) (pos int) {
+	return uniqueWindow(line, 4)
+}
+
+func part2(line string) (pos int) {
+	return uniqueWindow(line, 14)
+}
+
+func uniqueWindow(line string, width int) (pos int) {
+	var window string
+	var ch rune
+	set := make(map[rune]struct{})
+	for i := 0; i <= len(line)-width; i++ {
+		window = line[i : width+i]
+		for _, ch = range window {
+			set[ch] = struct{}{}
+		}
+		if len(set) == width {
+			return i + width
+		}
+		for ch = range set {
+			delete(set, ch)
+		}
+	}
+	return
+}
+
+type Solution struct {
+	Line string
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "tuning trouble",
+		Year:    2022,
+		Problem: 6,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test 1",
+				Input:   test1Data,
+				Expect1: solutions.Int(7),
+				Expect2: solutions.Int(19),
+			},
+			{
+				Name:    "Test 2",
+				Input:   test2Data,
+				Expect1: solutions.Int(5),
+				Expect2: solutions.Int(23),
+			},
+			{
+				Name:    "Test 3",
+				Input:   test3Data,
+				Expect1: solutions.Int(6),
+				Expect2: solutions.Int(23),
+			},
+			{
+				Name:    "Test 4",
+				Input:   test4Data,
+				Expect1: solutions.Int(10),
+				Expect2: solutions.Int(29),
+			},
+			{
+				Name:    "Test 5",
+				Input:   test5Data,
+				Expect1: solutions.Int(11),
+				Expect2: solutions.Int(26),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(1538),
+				Expect2: solutions.Int(2315),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	if !scanner.Scan() {
+		if err = scanner.Err(); err == nil {
+			err = fmt.Errorf("no datastream found")
+		}
+		return
+	}
+	s.Line = scanner.Text()
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	pos := part1(s.Line)
+	if pos == 0 {
+		err = fmt.Errorf("no start-of-packet marker found")
+		return
+	}
+	return solutions.Int(pos), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	pos := part2(s.Line)
+	if pos == 0 {
+		err = fmt.Errorf("no start-of-message marker found")
+		return
+	}
+	return solutions.Int(pos), nil
+}
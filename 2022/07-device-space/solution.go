@@ -1,15 +1,27 @@
-package main
+package devicespace
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"bytes"
+	_ "embed"
 	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
 type File struct {
 	Name string
 	Size int
@@ -84,18 +96,50 @@ func part2(f Filesystem) (dirSize int) {
 	return
 }
 
-func main() {
-	filesystem := NewFilesystem()
+type Solution struct {
+	Filesystem Filesystem
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "device space",
+		Year:    2022,
+		Problem: 7,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(95437),
+				Expect2: solutions.Int(24933642),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(1390824),
+				Expect2: solutions.Int(7490863),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Filesystem = NewFilesystem()
 	currentPath := "/"
 
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
 		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return util.Errorf(lineNo, 0, line, "expected command or listing")
+		}
 		switch fields[0] {
 		case "$":
 			switch fields[1] {
 			case "cd":
+				if len(fields) != 3 {
+					return util.Errorf(lineNo, 0, line, "expected cd with one directory")
+				}
 				switch fields[2] {
 				case "/":
 					// Absolute Path
@@ -111,20 +155,32 @@ func main() {
 				// NOOP
 			}
 		case "dir":
-			filesystem[filepath.Join(currentPath, fields[1])] = &Dir{
+			s.Filesystem[filepath.Join(currentPath, fields[1])] = &Dir{
 				Files: make([]File, 0, 16),
 			}
 		default:
-			size, _ := strconv.Atoi(fields[0])
+			var size int
+			if size, err = util.Atoi(lineNo, 1, fields[0]); err != nil {
+				return
+			}
 			name := fields[1]
-			dir := filesystem[currentPath]
+			dir, ok := s.Filesystem[currentPath]
+			if !ok {
+				return util.Errorf(lineNo, 0, currentPath, "listing unknown directory")
+			}
 			dir.Files = append(dir.Files, File{
 				Name: name,
 				Size: size,
 			})
 		}
 	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Filesystem)), nil
+}
 
-	fmt.Printf("Part 1: %d\n", part1(filesystem))
-	fmt.Printf("Part 2: %d\n", part2(filesystem))
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(part2(s.Filesystem)), nil
 }
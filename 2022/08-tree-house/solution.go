@@ -0,0 +1,99 @@
+package treehouse
+
+import (
+	_ "embed"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util/geom"
+	"github.com/jbaikge/advent-of-code/util/grid"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+// look walks away from p in the direction of step until a tree at least as
+// tall as the one at p blocks the view, returning how many trees were seen
+func look(trees *grid.Grid[int], p grid.Point, step grid.Point) (seen int, blocked bool) {
+	height := trees.At(p)
+	for _, q := range trees.Walk(p, step) {
+		seen++
+		if trees.At(q) >= height {
+			return seen, true
+		}
+	}
+	return seen, false
+}
+
+// Trees on the edge are always visible since nothing stands in their way
+func part1(trees *grid.Grid[int]) (total int) {
+	for _, p := range trees.Points() {
+		for _, step := range geom.Orthogonal {
+			if _, blocked := look(trees, p, step); !blocked {
+				total++
+				break
+			}
+		}
+	}
+	return
+}
+
+// Trees on the edge see nothing in one direction, so they always score zero
+func part2(trees *grid.Grid[int]) (score int) {
+	for _, p := range trees.Points() {
+		treeScore := 1
+		for _, step := range geom.Orthogonal {
+			seen, _ := look(trees, p, step)
+			treeScore *= seen
+		}
+		if treeScore > score {
+			score = treeScore
+		}
+	}
+	return
+}
+
+type Solution struct {
+	Trees *grid.Grid[int]
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "tree house",
+		Year:    2022,
+		Problem: 8,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(21),
+				Expect2: solutions.Int(8),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(1708),
+				Expect2: solutions.Int(504000),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Trees, err = grid.ParseDigits(data)
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Trees)), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Int(part2(s.Trees)), nil
+}
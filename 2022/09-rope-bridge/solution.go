@@ -0,0 +1,150 @@
+package ropebridge
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/geom"
+)
+
+//go:embed test1.txt
+var test1Data []byte
+
+//go:embed test2.txt
+var test2Data []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+const (
+	Up    = 'U'
+	Right = 'R'
+	Down  = 'D'
+	Left  = 'L'
+)
+
+type Motion struct {
+	Direction byte
+	Distance  int
+}
+
+type Point = geom.Point
+
+var steps = map[byte]Point{
+	Up:    geom.Up,
+	Right: geom.Right,
+	Down:  geom.Down,
+	Left:  geom.Left,
+}
+
+func move(knot *Point, m Motion) {
+	*knot = knot.Add(steps[m.Direction])
+}
+
+// tug drags the tail one step toward the head, diagonally if need be, once
+// the two are no longer touching
+func tug(head, tail *Point) {
+	if head.Chebyshev(*tail) > 1 {
+		*tail = tail.Add(head.Sub(*tail).Sign())
+	}
+}
+
+func walk(knotCount int, motions []Motion) (int, error) {
+	if knotCount < 1 {
+		return 0, fmt.Errorf("knot count must be at least 1; %d given", knotCount)
+	}
+	knots := make([]Point, knotCount)
+	visted := make(map[Point]bool)
+	for _, m := range motions {
+		for step := 0; step < m.Distance; step++ {
+			move(&knots[0], m)
+			for k := 1; k < len(knots); k++ {
+				tug(&knots[k-1], &knots[k])
+			}
+			visted[knots[len(knots)-1]] = true
+		}
+	}
+	return len(visted), nil
+}
+
+func part1(motions []Motion) (visited int, err error) {
+	return walk(2, motions)
+}
+
+func part2(motions []Motion) (visited int, err error) {
+	return walk(10, motions)
+}
+
+type Solution struct {
+	Motions []Motion
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "rope bridge",
+		Year:    2022,
+		Problem: 9,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test 1",
+				Input:   test1Data,
+				Expect1: solutions.Int(13),
+				Expect2: solutions.Int(1),
+			},
+			{
+				Name:    "Test 2",
+				Input:   test2Data,
+				Expect1: solutions.Int(88),
+				Expect2: solutions.Int(36),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(5619),
+				Expect2: solutions.Int(2376),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Motions = make([]Motion, 0, 2000)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		fields := util.Fields(line)
+		if len(fields) != 2 {
+			return util.Errorf(lineNo, 0, line, "expected direction and distance")
+		}
+		if dir := fields[0]; len(dir.Text) != 1 || !strings.Contains("URDL", dir.Text) {
+			return util.Errorf(lineNo, dir.Col, dir.Text, "expected U, R, D or L")
+		}
+		m := Motion{
+			Direction: fields[0].Text[0],
+		}
+		if m.Distance, err = util.Atoi(lineNo, fields[1].Col, fields[1].Text); err != nil {
+			return
+		}
+		s.Motions = append(s.Motions, m)
+	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	visited, err := part1(s.Motions)
+	return solutions.Int(visited), err
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	visited, err := part2(s.Motions)
+	return solutions.Int(visited), err
+}
@@ -0,0 +1,223 @@
+package cathoderaytube
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+// Part 2 draws a test pattern for the test data and spells PLPAFBCL for the input
+const (
+	testRender = `
+##..##..##..##..##..##..##..##..##..##..
+###...###...###...###...###...###...###.
+####....####....####....####....####....
+#####.....#####.....#####.....#####.....
+######......######......######......####
+#######.......#######.......#######.....
+`
+	inputRender = `
+###..#....###...##..####.###...##..#....
+#..#.#....#..#.#..#.#....#..#.#..#.#....
+#..#.#....#..#.#..#.###..###..#....#....
+###..#....###..####.#....#..#.#....#....
+#....#....#....#..#.#....#..#.#..#.#....
+#....####.#....#..#.#....###...##..####.
+`
+)
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+const (
+	OpAddX = "addx"
+	OpNoop = "noop"
+)
+
+type Instruction struct {
+	Op    string
+	Value int
+}
+
+func NewInstruction(line string) (inst Instruction, err error) {
+	fields := util.Fields(line)
+	if len(fields) == 0 {
+		err = util.Errorf(0, 0, "", "empty instruction")
+		return
+	}
+	inst.Op = fields[0].Text
+	switch {
+	case inst.Op == OpAddX && len(fields) == 2:
+		inst.Value, err = util.Atoi(0, fields[1].Col, fields[1].Text)
+	case inst.Op == OpNoop && len(fields) == 1:
+		// NOOP
+	default:
+		err = util.Errorf(0, 0, line, "expected addx V or noop")
+	}
+	return
+}
+
+func (i Instruction) Cycles() int {
+	switch i.Op {
+	case OpAddX:
+		return 2
+	case OpNoop:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type Machine struct {
+	PC int // Program Counter
+	X  int // Register X
+}
+
+func NewMachine() *Machine {
+	return &Machine{
+		X: 1,
+	}
+}
+
+func (m *Machine) Apply(inst Instruction) {
+	if inst.Op == OpAddX {
+		m.X += inst.Value
+	}
+}
+
+func part1(instructions []Instruction) (total int) {
+	breakpoints := make([]int, 0, 10)
+	for i := 20; i < 1000; i += 40 {
+		breakpoints = append(breakpoints, i)
+	}
+	bp := 0
+	m := NewMachine()
+	for _, inst := range instructions {
+		cycles := inst.Cycles()
+		for cycle := 0; cycle < cycles; cycle++ {
+			// Bump program counter
+			m.PC++
+			// Read register (possibly) between instructions
+			if bp == len(breakpoints) {
+				return
+			}
+			if breakpoint := breakpoints[bp]; m.PC == breakpoint {
+				total += m.X * breakpoint
+				bp++
+			}
+			// Apply instruction on last cycle
+			if cycle == cycles-1 {
+				m.Apply(inst)
+			}
+		}
+	}
+	return
+}
+
+func part2(instructions []Instruction) (screen string) {
+	// Set up the screen pixels
+	rows, cols := 6, 40
+	pixels := make([][]byte, rows)
+	for i := range pixels {
+		pixels[i] = bytes.Repeat([]byte{'.'}, cols)
+	}
+
+	sprite := bytes.Repeat([]byte{'.'}, cols)
+	illuminated := make([]int, 3)
+	m := NewMachine()
+	for _, inst := range instructions {
+		cycles := inst.Cycles()
+		for cycle := 0; cycle < cycles; cycle++ {
+			if m.PC == rows*cols {
+				break
+			}
+			row := m.PC / cols
+			col := m.PC % cols
+			// Illuminate sprite
+			for i := -1; i < 2; i++ {
+				if pos := m.X + i; pos >= 0 && pos < len(sprite) {
+					sprite[pos] = '#'
+					illuminated = append(illuminated, pos)
+				}
+			}
+			// Transfer sprite state
+			pixels[row][col] = sprite[col]
+			// Debug
+			// fmt.Printf("PC: %3d Row: %d Pos: %3d Sprite: %s\n", m.PC, row, m.X, string(sprite))
+			// Bump program counter
+			m.PC++
+			// Apply instruction on last cycle
+			if cycle == cycles-1 {
+				m.Apply(inst)
+			}
+			// Darken lit sprite pixels
+			for _, pos := range illuminated {
+				sprite[pos] = '.'
+			}
+			illuminated = illuminated[0:0]
+		}
+	}
+
+	for _, row := range pixels {
+		screen += string(row) + "\n"
+	}
+	return
+}
+
+type Solution struct {
+	Instructions []Instruction
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "cathode ray tube",
+		Year:    2022,
+		Problem: 10,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(13140),
+				Expect2: solutions.Render(testRender),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(12560),
+				Expect2: solutions.Render(inputRender),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Instructions = make([]Instruction, 0, 200)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		var inst Instruction
+		if inst, err = NewInstruction(scanner.Text()); err != nil {
+			return util.AtLine(err, lineNo)
+		}
+		s.Instructions = append(s.Instructions, inst)
+	}
+	return scanner.Err()
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Instructions)), nil
+}
+
+// The screen renders eight capital letters
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	return solutions.Render(part2(s.Instructions)), nil
+}
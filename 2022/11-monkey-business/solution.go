@@ -0,0 +1,292 @@
+package monkeybusiness
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/numtheory"
+	"github.com/jbaikge/advent-of-code/util/parse"
+)
+
+//go:embed test.txt
+var testData []byte
+
+//go:embed input.txt
+var inputData []byte
+
+func init() {
+	solutions.Register(solutions.WithContext(new(Solution)))
+}
+
+const OperationOld = -1
+
+type Operation struct {
+	A  int
+	B  int
+	Op byte
+}
+
+func NewOperation(raw string) (o Operation, err error) {
+	fields := util.Fields(raw)
+	if len(fields) != 5 {
+		err = util.Errorf(0, 0, raw, "expected new = a op b")
+		return
+	}
+	if v := fields[2]; v.Text == "old" {
+		o.A = OperationOld
+	} else if o.A, err = util.Atoi(0, v.Col, v.Text); err != nil {
+		return
+	}
+	if v := fields[4]; v.Text == "old" {
+		o.B = OperationOld
+	} else if o.B, err = util.Atoi(0, v.Col, v.Text); err != nil {
+		return
+	}
+	if op := fields[3]; op.Text != "+" && op.Text != "*" {
+		err = util.Errorf(0, op.Col, op.Text, "expected + or *")
+		return
+	}
+	o.Op = fields[3].Text[0]
+	return
+}
+
+func (o Operation) Calculate(old int) int {
+	a, b := o.A, o.B
+	if a == OperationOld {
+		a = old
+	}
+	if b == OperationOld {
+		b = old
+	}
+	switch o.Op {
+	case '+':
+		return a + b
+	case '*':
+		return a * b
+	}
+	return 0
+}
+
+type Test struct {
+	DivisibleBy int
+	IfTrue      int // Throw to Monkey (num)
+	IfFalse     int // Throw to Monkey (num)
+}
+
+func (t Test) ThrowTo(v int) int {
+	if v%t.DivisibleBy == 0 {
+		return t.IfTrue
+	}
+	return t.IfFalse
+}
+
+type Monkey struct {
+	Num           int
+	StartingItems []int
+	Operation     Operation
+	Test          Test
+}
+
+func NewMonkey(num int) *Monkey {
+	return &Monkey{
+		Num:           num,
+		StartingItems: make([]int, 0, 8),
+	}
+}
+
+func (m *Monkey) CalculateWorry(old int) int {
+	return m.Operation.Calculate(old)
+}
+
+type MonkeyStats struct {
+	Items     []int
+	Inspected int
+}
+
+func part1(monkeys []*Monkey) (total int) {
+	const Rounds = 20
+
+	stats := make([]MonkeyStats, len(monkeys))
+	for i, monkey := range monkeys {
+		stats[i].Items = append(stats[i].Items, monkey.StartingItems...)
+	}
+
+	for round := 1; round <= Rounds; round++ {
+		// fmt.Printf("Round %d:\n", round)
+		for m, monkey := range monkeys {
+			// fmt.Printf("  Monkey %d:\n", monkey.Num)
+			for _, item := range stats[m].Items {
+				stats[m].Inspected++
+				// fmt.Printf("    Inspecting item: %d\n", item)
+				newItem := monkey.CalculateWorry(item)
+				// fmt.Printf("      Worry level increased to %d\n", newItem)
+				newItem /= 3
+				// fmt.Printf("      Bored, worry level decreased to %d\n", newItem)
+				idx := monkey.Test.ThrowTo(newItem)
+				// fmt.Printf("      Throwing to %d\n", idx)
+				stats[idx].Items = append(stats[idx].Items, newItem)
+			}
+			// Empty current monkey's hands
+			stats[m].Items = stats[m].Items[:0]
+		}
+	}
+
+	inspected := make([]int, len(monkeys))
+	for m, stat := range stats {
+		inspected[m] = stat.Inspected
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(inspected)))
+
+	return inspected[0] * inspected[1]
+}
+
+func part2(monkeys []*Monkey) (total int, err error) {
+	const Rounds = 10000
+
+	// Keeping worry levels modulo a common multiple of every divisor leaves
+	// each monkey's test unchanged while stopping the levels growing
+	divisors := make([]int, len(monkeys))
+	stats := make([]MonkeyStats, len(monkeys))
+	for i, monkey := range monkeys {
+		stats[i].Items = append(stats[i].Items, monkey.StartingItems...)
+		divisors[i] = monkey.Test.DivisibleBy
+	}
+	lcm, err := numtheory.LCM(divisors...)
+	if err != nil {
+		return 0, fmt.Errorf("common multiple of divisors: %w", err)
+	}
+
+	for round := 1; round <= Rounds; round++ {
+		// fmt.Printf("Round %d:\n", round)
+		for m, monkey := range monkeys {
+			// fmt.Printf("  Monkey %d:\n", monkey.Num)
+			for _, item := range stats[m].Items {
+				stats[m].Inspected++
+				// fmt.Printf("    Inspecting item: %d\n", item)
+				newItem := monkey.CalculateWorry(item)
+				// fmt.Printf("      Worry level increased to %d\n", newItem)
+				newItem %= lcm
+				// fmt.Printf("      Decrease by modulo with %d: %d\n", lcm, newItem)
+				idx := monkey.Test.ThrowTo(newItem)
+				// fmt.Printf("      Throwing to %d\n", idx)
+				stats[idx].Items = append(stats[idx].Items, newItem)
+			}
+			// Empty current monkey's hands
+			stats[m].Items = stats[m].Items[:0]
+		}
+	}
+
+	inspected := make([]int, len(monkeys))
+	for m, stat := range stats {
+		inspected[m] = stat.Inspected
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(inspected)))
+
+	return inspected[0] * inspected[1], nil
+}
+
+type Solution struct {
+	Monkeys []*Monkey
+}
+
+func (*Solution) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "monkey business",
+		Year:    2022,
+		Problem: 11,
+		Datas: []solutions.Data{
+			{
+				Name:    "Test",
+				Input:   testData,
+				Expect1: solutions.Int(10605),
+				Expect2: solutions.Int(2713310158),
+			},
+			{
+				Name:    "Input",
+				Input:   inputData,
+				Expect1: solutions.Int(58322),
+				Expect2: solutions.Int(13937702909),
+			},
+		},
+	}
+}
+
+func (s *Solution) Parse(data []byte) (err error) {
+	s.Monkeys = make([]*Monkey, 0, 8)
+	for _, block := range parse.Paragraphs(parse.Lines(data)) {
+		if len(block) != 6 {
+			return block[0].Errorf(0, "", "expected six lines describing a monkey")
+		}
+
+		var num int
+		if err = block[0].Scan("Monkey %d:", &num); err != nil {
+			return
+		}
+		if num != len(s.Monkeys) {
+			return block[0].Errorf(len("Monkey ")+1, strconv.Itoa(num), "expected monkey %d", len(s.Monkeys))
+		}
+		monkey := NewMonkey(num)
+
+		var items string
+		if err = block[1].Scan("  Starting items: %s", &items); err != nil {
+			return
+		}
+		for _, item := range util.Split(items, ", ") {
+			item.Col += len("  Starting items: ")
+			var n int
+			if n, err = block[1].Atoi(item); err != nil {
+				return
+			}
+			monkey.StartingItems = append(monkey.StartingItems, n)
+		}
+
+		var operation string
+		if err = block[2].Scan("  Operation: %s", &operation); err != nil {
+			return
+		}
+		if monkey.Operation, err = NewOperation(operation); err != nil {
+			return util.At(err, block[2].No, len("  Operation: ")+1)
+		}
+
+		if err = block[3].Scan("  Test: divisible by %d", &monkey.Test.DivisibleBy); err != nil {
+			return
+		}
+		if monkey.Test.DivisibleBy < 1 {
+			return block[3].Errorf(len("  Test: divisible by ")+1, strconv.Itoa(monkey.Test.DivisibleBy), "expected positive divisor")
+		}
+		if err = block[4].Scan("    If true: throw to monkey %d", &monkey.Test.IfTrue); err != nil {
+			return
+		}
+		if err = block[5].Scan("    If false: throw to monkey %d", &monkey.Test.IfFalse); err != nil {
+			return
+		}
+		s.Monkeys = append(s.Monkeys, monkey)
+	}
+
+	if len(s.Monkeys) < 2 {
+		return fmt.Errorf("found %d monkeys, need at least 2", len(s.Monkeys))
+	}
+	for _, monkey := range s.Monkeys {
+		for _, to := range []int{monkey.Test.IfTrue, monkey.Test.IfFalse} {
+			if to < 0 || to >= len(s.Monkeys) {
+				return fmt.Errorf("monkey %d throws to missing monkey %d", monkey.Num, to)
+			}
+		}
+	}
+	return
+}
+
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	return solutions.Int(part1(s.Monkeys)), nil
+}
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	total, err := part2(s.Monkeys)
+	return solutions.Int(total), err
+}
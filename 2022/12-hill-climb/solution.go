@@ -5,11 +5,11 @@ import (
 	"embed"
 	"fmt"
 	"io"
-	"math"
-	"sort"
-	"sync"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/geom"
+	"github.com/jbaikge/advent-of-code/util/search"
 )
 
 //go:embed *.txt
@@ -20,17 +20,14 @@ const (
 	BestSignalPosition = 'E'
 )
 
-type Point struct {
-	X int
-	Y int
-}
-
-func (p Point) String() string {
-	return fmt.Sprintf("(%2d, %2d)", p.X, p.Y)
-}
+type Point = geom.Point
 
 var _ util.Solution = new(Solution)
 
+func init() {
+	solutions.Register(solutions.Adapt(2022, 12, "hill climb", new(Solution)))
+}
+
 type Solution struct {
 	HeightMap map[Point]byte
 }
@@ -45,205 +42,80 @@ func (s *Solution) Parse(r io.Reader) (err error) {
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		for x, height := range scanner.Bytes() {
-			s.HeightMap[Point{x, y}] = height
+			s.HeightMap[Point{X: x, Y: y}] = height
 		}
 		y++
 	}
 	return
 }
 
-func (s Solution) Part1(w io.Writer) (err error) {
-	var start, end Point
-	grid := make(map[Point]Node)
-	for point, height := range s.HeightMap {
-		if height == CurrentPosition {
-			start = point
-		}
-		if height == BestSignalPosition {
-			end = point
-		}
-		grid[point] = Node{Point: point, Height: height}
+// elevation reads the start as a and the end as z
+func elevation(height byte) byte {
+	switch height {
+	case CurrentPosition:
+		return 'a'
+	case BestSignalPosition:
+		return 'z'
 	}
+	return height
+}
 
-	search := &AStar{
-		Grid:  grid,
-		Start: Node{Point: start, Height: CurrentPosition},
-		Goal:  Node{Point: end, Height: BestSignalPosition},
+// climbable lists the squares next to p no more than one higher
+func (s Solution) climbable(p Point) (next []Point) {
+	next = make([]Point, 0, len(geom.Orthogonal))
+	for _, step := range geom.Orthogonal {
+		q := p.Add(step)
+		if height, found := s.HeightMap[q]; found && elevation(height) <= elevation(s.HeightMap[p])+1 {
+			next = append(next, q)
+		}
 	}
-	path := search.Path(search.Start)
-	// for _, node := range path {
-	// 	fmt.Fprintln(w, node)
-	// }
-	fmt.Fprintf(w, "Part 1: %d\n", len(path)-1)
 	return
 }
 
-func (s Solution) Part2(w io.Writer) (err error) {
-	var end Point
-	startNodes := make([]Node, 0, 1000)
-	grid := make(map[Point]Node)
-	for point, height := range s.HeightMap {
-		if height == BestSignalPosition {
-			end = point
-		}
-		if height == 'a' {
-			startNodes = append(startNodes, Node{Point: point, Height: height})
+func (s Solution) find(height byte) (points []Point) {
+	for point, h := range s.HeightMap {
+		if h == height {
+			points = append(points, point)
 		}
-		grid[point] = Node{Point: point, Height: height}
 	}
+	return
+}
 
-	search := &AStar{
-		Grid: grid,
-		Goal: Node{Point: end, Height: BestSignalPosition},
+func (s Solution) Part1(w io.Writer) (err error) {
+	start, end := s.find(CurrentPosition), s.find(BestSignalPosition)
+	if len(start) != 1 || len(end) != 1 {
+		return fmt.Errorf("expected one start and one end, got %d and %d", len(start), len(end))
 	}
 
-	ch := make(chan int, len(startNodes))
-	var wg sync.WaitGroup
-	wg.Add(len(startNodes))
-	for _, node := range startNodes {
-		go func(node Node) {
-			path := search.Path(node)
-			wg.Done()
-			length := len(path)
-			if length == 0 {
-				return
-			}
-			ch <- length - 1
-		}(node)
+	edges := func(p Point) (edges []search.Edge[Point]) {
+		for _, q := range s.climbable(p) {
+			edges = append(edges, search.Edge[Point]{To: q, Cost: 1})
+		}
+		return
 	}
-
-	wg.Wait()
-	close(ch)
-
-	lengths := make([]int, 0, len(startNodes))
-	for length := range ch {
-		lengths = append(lengths, length)
+	distance := func(p Point) int {
+		return p.Manhattan(end[0])
 	}
-
-	sort.Ints(lengths)
-	fmt.Fprintf(w, "Part 2: %v\n", lengths[0])
+	path, ok := search.AStar(start[0], search.Is(end[0]), edges, distance)
+	if !ok {
+		return fmt.Errorf("no path from %s to %s", start[0], end[0])
+	}
+	fmt.Fprintf(w, "Part 1: %d\n", path.Steps())
 	return
 }
 
-type Node struct {
-	Point  Point
-	Height byte
-}
-
-func (n Node) String() string {
-	return fmt.Sprintf("%s %s", n.Point.String(), string(n.Height))
-}
-
-// Adapted from pseudocode found on Wikipedia
-// Ref: https://en.wikipedia.org/wiki/A*_search_algorithm
-type AStar struct {
-	Grid  map[Point]Node
-	Start Node
-	Goal  Node
-}
-
-func (a *AStar) Cost(n Node) (cost float64) {
-	x := n.Point.X - a.Goal.Point.X
-	y := n.Point.Y - a.Goal.Point.Y
-	return math.Sqrt(float64(x*x + y*y))
-}
-
-func (a *AStar) Path(startNode Node) (path []Node) {
-	open := []Node{startNode}
-	from := make(map[Node]Node)
-	gScore := map[Node]int{startNode: 0}
-	fScore := map[Node]float64{startNode: a.Cost(startNode)}
-
-	for len(open) > 0 {
-		// Determine current node, or the node in open with the lowest fScore
-		var current Node
-		var currentIdx int
-		minScore := math.MaxFloat64
-		for i, node := range open {
-			if score := fScore[node]; score < minScore {
-				minScore = score
-				current = node
-				currentIdx = i
-			}
-		}
-
-		if current == a.Goal {
-			// fmt.Println("Found the goal!")
-			return a.Reconstruct(from, current)
-		}
-
-		// Remove node from open
-		open = append(open[:currentIdx], open[currentIdx+1:]...)
-
-		points := []Point{
-			{current.Point.X, current.Point.Y + 1},
-			{current.Point.X + 1, current.Point.Y},
-			{current.Point.X, current.Point.Y - 1},
-			{current.Point.X - 1, current.Point.Y},
-		}
-		for _, point := range points {
-			neighbor, found := a.Grid[point]
-			if !found {
-				continue
-			}
-
-			valid := false
-			if current.Height == CurrentPosition {
-				valid = true
-			}
-			if current.Height > neighbor.Height {
-				valid = true
-			}
-			if current.Height == neighbor.Height {
-				valid = true
-			}
-			if current.Height+1 == neighbor.Height {
-				valid = true
-			}
-			if current.Height == 'z' && neighbor.Height == a.Goal.Height {
-				valid = true
-			}
-			if !valid {
-				continue
-			}
-
-			tentativeGScore := gScore[current] + 1
-			neighborGScore, found := gScore[neighbor]
-			if !found {
-				neighborGScore = math.MaxInt
-			}
-			if tentativeGScore < neighborGScore {
-				from[neighbor] = current
-				gScore[neighbor] = tentativeGScore
-				fScore[neighbor] = float64(tentativeGScore) + a.Cost(neighbor)
-
-				// "if neighbor not in open, open.add(neighbor)"
-				openFound := false
-				for _, node := range open {
-					if node == neighbor {
-						openFound = true
-					}
-				}
-				if !openFound {
-					open = append(open, neighbor)
-				}
-			}
-		}
+func (s Solution) Part2(w io.Writer) (err error) {
+	end := s.find(BestSignalPosition)
+	if len(end) != 1 {
+		return fmt.Errorf("expected one end, got %d", len(end))
 	}
 
-	return
-}
-
-func (a *AStar) Reconstruct(from map[Node]Node, current Node) (path []Node) {
-	path = make([]Node, 0, len(from)+1)
-	path = append(path, current)
-	for {
-		newNode, found := from[current]
-		if !found {
-			break
-		}
-		current = newNode
-		path = append([]Node{current}, path...)
+	// Searching from every low square at once finds whichever is closest
+	starts := append(s.find('a'), s.find(CurrentPosition)...)
+	path, ok := search.MultiBFS(starts, search.Is(end[0]), s.climbable)
+	if !ok {
+		return fmt.Errorf("no path to %s", end[0])
 	}
+	fmt.Fprintf(w, "Part 2: %d\n", path.Steps())
 	return
 }
@@ -4,13 +4,14 @@ import (
 	"bufio"
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"math"
 	"sort"
 	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
 )
 
@@ -20,18 +21,54 @@ var Files embed.FS
 var _ util.Solution = new(Solution)
 var _ sort.Interface = make(Parsed, 0)
 
+func init() {
+	solutions.Register(solutions.Adapt(2022, 13, "distress", new(Solution)))
+}
+
 type Packet struct {
 	Raw string
 }
 
-func (p Packet) Parse() (out []interface{}) {
+func (p Packet) Parse() (out []interface{}, err error) {
 	out = make([]interface{}, 0)
-	if err := json.NewDecoder(strings.NewReader(p.Raw)).Decode(&out); err != nil {
-		log.Fatalf("Error parsing %s: %v", p.Raw, err)
+	if err = json.NewDecoder(strings.NewReader(p.Raw)).Decode(&out); err != nil {
+		return nil, &util.ParseError{Col: offset(err), Err: err}
+	}
+	if err = validate(out); err != nil {
+		return nil, &util.ParseError{Text: p.Raw, Err: err}
 	}
 	return
 }
 
+// offset finds the column a decoding error points at, if any
+func offset(err error) int {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return int(syntaxErr.Offset)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return int(typeErr.Offset)
+	}
+	return 0
+}
+
+// Packets may only hold integers and lists
+func validate(list []interface{}) error {
+	for _, v := range list {
+		switch v := v.(type) {
+		case float64:
+		case []interface{}:
+			if err := validate(v); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected value: %v", v)
+		}
+	}
+	return nil
+}
+
 type Parsed [][]interface{}
 
 func (p Parsed) Len() int {
@@ -71,8 +108,6 @@ func (p Parsed) compare(left, right []interface{}, depth int) (ordered int) {
 			leftNum = v
 		case []interface{}:
 			leftSlice = v
-		default:
-			log.Fatalf("Not sure how to parse left value: left[%d]: %T %+v\n", i, v, v)
 		}
 
 		switch v := right[i].(type) {
@@ -84,8 +119,6 @@ func (p Parsed) compare(left, right []interface{}, depth int) (ordered int) {
 			rightNum = v
 		case []interface{}:
 			rightSlice = v
-		default:
-			log.Fatalf("Not sure how to parse right value: right[%d]: %T %+v\n", i, v, v)
 		}
 
 		// Actually compare numerical values
@@ -146,12 +179,22 @@ func (s Solution) Files() embed.FS {
 func (s *Solution) Parse(r io.Reader) (err error) {
 	s.Packets = make([]Packet, 0, 300)
 	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
 		if line == "" {
 			continue
 		}
-		s.Packets = append(s.Packets, Packet{Raw: line})
+		packet := Packet{Raw: line}
+		if _, err = packet.Parse(); err != nil {
+			return util.AtLine(err, lineNo)
+		}
+		s.Packets = append(s.Packets, packet)
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	if len(s.Packets)%2 != 0 {
+		return fmt.Errorf("packets must come in pairs, got %d", len(s.Packets))
 	}
 	return
 }
@@ -159,7 +202,11 @@ func (s *Solution) Parse(r io.Reader) (err error) {
 func (s Solution) Part1(w io.Writer) (err error) {
 	parsed := make(Parsed, 0, len(s.Packets))
 	for _, packet := range s.Packets {
-		parsed = append(parsed, packet.Parse())
+		out, err := packet.Parse()
+		if err != nil {
+			return err
+		}
+		parsed = append(parsed, out)
 	}
 
 	var correct int
@@ -177,7 +224,11 @@ func (s Solution) Part1(w io.Writer) (err error) {
 func (s Solution) Part2(w io.Writer) (err error) {
 	parsed := make(Parsed, 0, len(s.Packets))
 	for _, packet := range s.Packets {
-		parsed = append(parsed, packet.Parse())
+		out, err := packet.Parse()
+		if err != nil {
+			return err
+		}
+		parsed = append(parsed, out)
 	}
 
 	// Add dividers
@@ -2,15 +2,14 @@ package reservoir
 
 import (
 	"bufio"
-	"bytes"
 	"embed"
 	"fmt"
 	"io"
-	"math"
-	"strconv"
 	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/grid"
 )
 
 const (
@@ -24,36 +23,43 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
-type Point struct {
-	X int
-	Y int
+func init() {
+	solutions.Register(solutions.Adapt(2022, 14, "reservoir", new(Solution)))
 }
 
-func (p Point) String() string {
-	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
-}
+type Point = grid.Point
 
 type Path []Point
 
-func ParsePath(line string) (p Path) {
-	points := strings.Split(line, " -> ")
+func ParsePath(line string) (p Path, err error) {
+	points := util.Split(line, " -> ")
 	p = make(Path, len(points))
 	for i, point := range points {
-		values := strings.SplitN(point, ",", 2)
-		p[i].X, _ = strconv.Atoi(values[0])
-		p[i].Y, _ = strconv.Atoi(values[1])
+		x, y, ok := strings.Cut(point.Text, ",")
+		if !ok {
+			return nil, util.Errorf(0, point.Col, point.Text, "expected x,y")
+		}
+		if p[i].X, err = util.Atoi(0, point.Col, x); err != nil {
+			return nil, err
+		}
+		if p[i].Y, err = util.Atoi(0, point.Col+len(x)+1, y); err != nil {
+			return nil, err
+		}
+		if i > 0 && p[i].X != p[i-1].X && p[i].Y != p[i-1].Y {
+			return nil, util.Errorf(0, point.Col, point.Text, "path segment is not straight")
+		}
 	}
 	return
 }
 
 type Cave struct {
-	Tiles map[Point]byte
+	Tiles *grid.Sparse[byte]
 	Floor int // Y-height of floor (maxY + 2)
 }
 
 func NewCave() *Cave {
 	return &Cave{
-		Tiles: make(map[Point]byte),
+		Tiles: grid.NewSparse[byte](),
 	}
 }
 
@@ -73,7 +79,7 @@ func (c *Cave) BuildRocks(paths []Path) {
 
 			for x := minX; x <= maxX; x++ {
 				for y := minY; y <= maxY; y++ {
-					c.Tiles[Point{X: x, Y: y}] = Rock
+					c.Tiles.Set(Point{X: x, Y: y}, Rock)
 				}
 			}
 
@@ -85,7 +91,7 @@ func (c *Cave) BuildRocks(paths []Path) {
 }
 
 func (c *Cave) Count() (total int) {
-	for _, tile := range c.Tiles {
+	for _, tile := range c.Tiles.Cells {
 		if tile == Sand {
 			total++
 		}
@@ -103,46 +109,18 @@ func (c *Cave) DropSand(currentPos Point) (newPos Point) {
 		{X: currentPos.X + 1, Y: currentPos.Y + 1},
 	}
 	for _, move := range possibleMoves {
-		if tile, ok := c.Tiles[move]; ok && tile != Air {
+		if tile, ok := c.Tiles.Get(move); ok && tile != Air {
 			continue
 		}
-		c.Tiles[currentPos] = Air
-		c.Tiles[move] = Sand
+		c.Tiles.Set(currentPos, Air)
+		c.Tiles.Set(move, Sand)
 		return move
 	}
 	return currentPos
 }
 
-func (c Cave) String() (str string) {
-	var min, max Point
-	// min.X, min.Y = math.MaxInt, math.MaxInt
-	min.X = math.MaxInt
-
-	for p := range c.Tiles {
-		if p.X < min.X {
-			min.X = p.X
-		}
-		if p.X > max.X {
-			max.X = p.X
-		}
-		// if p.Y < min.Y {
-		// 	min.Y = p.Y
-		// }
-		if p.Y > max.Y {
-			max.Y = p.Y
-		}
-	}
-
-	grid := make([][]byte, max.Y-min.Y+1)
-	for i := range grid {
-		grid[i] = bytes.Repeat([]byte{Air}, max.X-min.X+1)
-	}
-
-	for p, tile := range c.Tiles {
-		grid[p.Y-min.Y][p.X-min.X] = tile
-	}
-
-	return string(bytes.Join(grid, []byte{'\n'}))
+func (c Cave) String() string {
+	return c.Tiles.String()
 }
 
 type Solution struct {
@@ -159,11 +137,15 @@ func (s *Solution) Parse(r io.Reader) (err error) {
 
 	s.Paths = make([]Path, 0, 141)
 	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
-		s.Paths = append(s.Paths, ParsePath(scanner.Text()))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		path, err := ParsePath(scanner.Text())
+		if err != nil {
+			return util.AtLine(err, lineNo)
+		}
+		s.Paths = append(s.Paths, path)
 	}
 
-	return
+	return scanner.Err()
 }
 
 func (s Solution) Part1(w io.Writer) (err error) {
@@ -174,14 +156,14 @@ func (s Solution) Part1(w io.Writer) (err error) {
 	for steps := 0; steps < 200000; steps++ {
 		// Hit the floor
 		if grain.Y == cave.Floor-1 {
-			delete(cave.Tiles, grain)
+			cave.Tiles.Delete(grain)
 			break
 		}
 
 		// At rest, start a new grain
 		if lastGrain == grain {
 			grain.X, grain.Y = 500, 0
-			cave.Tiles[grain] = Sand
+			cave.Tiles.Set(grain, Sand)
 		}
 
 		lastGrain = grain
@@ -214,7 +196,7 @@ func (s Solution) Part2(w io.Writer) (err error) {
 
 		if startNew {
 			grain.X, grain.Y = s.Pour.X, s.Pour.Y
-			cave.Tiles[grain] = Sand
+			cave.Tiles.Set(grain, Sand)
 			startNew = false
 		}
 
@@ -1,15 +1,16 @@
 package sensors
 
 import (
-	"bufio"
 	"embed"
 	"fmt"
 	"io"
 	"math"
-	"strconv"
-	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/geom"
+	"github.com/jbaikge/advent-of-code/util/interval"
+	"github.com/jbaikge/advent-of-code/util/parse"
 )
 
 const (
@@ -23,14 +24,11 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
-type Point struct {
-	X int
-	Y int
+func init() {
+	solutions.Register(solutions.Adapt(2022, 15, "sensors", new(Solution)))
 }
 
-func (p Point) String() string {
-	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
-}
+type Point = geom.Point
 
 type Sensor struct {
 	Position Point
@@ -42,17 +40,7 @@ func (s Sensor) BeaconDistance() int {
 }
 
 func (s Sensor) Distance(to Point) int {
-	x := s.Position.X - to.X
-	if x < 0 {
-		x *= -1
-	}
-
-	y := s.Position.Y - to.Y
-	if y < 0 {
-		y *= -1
-	}
-
-	return x + y
+	return s.Position.Manhattan(to)
 }
 
 type Solution struct {
@@ -65,20 +53,27 @@ func (s Solution) Files() embed.FS {
 
 func (s *Solution) Parse(r io.Reader) (err error) {
 	s.Sensors = make([]Sensor, 0, 33)
-	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
-		line := scanner.Text()
-		// Sensor at x=2, y=18: closest beacon is at x=-2, y=15
-		fields := strings.FieldsFunc(line, func(r rune) bool {
-			return r == ' ' || r == '=' || r == ',' || r == ':'
-		})
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return
+	}
+	for _, line := range lines {
 		var sensor Sensor
-		sensor.Position.X, _ = strconv.Atoi(fields[3])
-		sensor.Position.Y, _ = strconv.Atoi(fields[5])
-		sensor.Beacon.X, _ = strconv.Atoi(fields[11])
-		sensor.Beacon.Y, _ = strconv.Atoi(fields[13])
+		err = line.Scan(
+			"Sensor at x=%d, y=%d: closest beacon is at x=%d, y=%d",
+			&sensor.Position.X,
+			&sensor.Position.Y,
+			&sensor.Beacon.X,
+			&sensor.Beacon.Y,
+		)
+		if err != nil {
+			return
+		}
 		s.Sensors = append(s.Sensors, sensor)
 	}
+	if len(s.Sensors) == 0 {
+		return fmt.Errorf("no sensors")
+	}
 	return
 }
 
@@ -88,38 +83,20 @@ func (s Solution) Part1(w io.Writer) (err error) {
 		targetY = 2000000
 	}
 
-	beacons := make(map[int]bool)
+	// Each sensor sees a stretch of the row, shorter the further away it is;
+	// too far away and the stretch is empty, which Merge drops
+	seen := make([]interval.Interval, 0, len(s.Sensors))
+	beacons := make([]interval.Interval, 0, len(s.Sensors))
 	for _, sensor := range s.Sensors {
+		spread := sensor.BeaconDistance() - geom.Abs(targetY-sensor.Position.Y)
+		seen = append(seen, interval.New(sensor.Position.X-spread, sensor.Position.X+spread))
 		if sensor.Beacon.Y == targetY {
-			beacons[sensor.Beacon.X] = true
+			beacons = append(beacons, interval.New(sensor.Beacon.X, sensor.Beacon.X))
 		}
 	}
+	empty := interval.Merge(seen...).Difference(interval.Merge(beacons...))
 
-	points := make(map[int]bool)
-	for _, sensor := range s.Sensors {
-		dist := sensor.BeaconDistance()
-		y := sensor.Position.Y
-		fromAbove := y <= targetY && y+dist >= targetY
-		fromBelow := y >= targetY && y-dist <= targetY
-		crossesTarget := fromAbove || fromBelow
-		if !crossesTarget {
-			continue
-		}
-
-		targetDistance := targetY - y
-		if targetDistance < 0 {
-			targetDistance *= -1
-		}
-		xSpread := dist - targetDistance
-		for x := sensor.Position.X - xSpread; x <= sensor.Position.X+xSpread; x++ {
-			if _, ok := beacons[x]; ok {
-				continue
-			}
-			points[x] = true
-		}
-	}
-
-	fmt.Fprintf(w, "Part 1: %d\n", len(points))
+	fmt.Fprintf(w, "Part 1: %d\n", empty.Len())
 	return
 }
 
@@ -153,22 +130,15 @@ func (s Solution) Part2(w io.Writer) (err error) {
 	var found Point
 	for _, sensor := range s.Sensors {
 		beaconDistance := sensor.BeaconDistance()
-		perimeter := make([]Point, 0, beaconDistance*2)
-		// NE quadrant
-		for x, y := sensor.Position.X, sensor.Position.Y-beaconDistance-1; x <= sensor.Position.X+beaconDistance+1; x, y = x+1, y+1 {
-			perimeter = append(perimeter, Point{X: x, Y: y})
-		}
-		// SE quadrant
-		for x, y := sensor.Position.X, sensor.Position.Y+beaconDistance+1; x <= sensor.Position.X+beaconDistance+1; x, y = x+1, y-1 {
-			perimeter = append(perimeter, Point{X: x, Y: y})
-		}
-		// NW quadrant
-		for x, y := sensor.Position.X-beaconDistance-1, sensor.Position.Y; x <= sensor.Position.X; x, y = x+1, y-1 {
-			perimeter = append(perimeter, Point{X: x, Y: y})
+		// The perimeter is a diamond, so join up its four corners
+		corners := make([]Point, 0, 5)
+		for _, step := range geom.Orthogonal {
+			corners = append(corners, sensor.Position.Add(step.Scale(beaconDistance+1)))
 		}
-		// SW quadrant
-		for x, y := sensor.Position.X-beaconDistance-1, sensor.Position.Y; x <= sensor.Position.X; x, y = x+1, y+1 {
-			perimeter = append(perimeter, Point{X: x, Y: y})
+		corners = append(corners, corners[0])
+		perimeter := make([]Point, 0, (beaconDistance+1)*4+4)
+		for i := 1; i < len(corners); i++ {
+			perimeter = append(perimeter, geom.Line(corners[i-1], corners[i])...)
 		}
 		// Walk around the outside and find out if any sensor
 		// radii overlap
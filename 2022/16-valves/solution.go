@@ -2,19 +2,24 @@ package valves
 
 import (
 	"bufio"
+	"context"
 	"embed"
 	"fmt"
 	"io"
-	"strconv"
-	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/search"
 )
 
 //go:embed *.txt
 var Files embed.FS
 
-var _ util.Solution = new(Solution)
+var _ util.Cancellable = new(Solution)
+
+func init() {
+	solutions.Register(solutions.Adapt(2022, 16, "valves", new(Solution)))
+}
 
 type Valve struct {
 	Name      string
@@ -26,48 +31,6 @@ func (v Valve) String() string {
 	return fmt.Sprintf("%s.%d", v.Name, v.FlowRate)
 }
 
-type Node struct {
-	Name string
-	Path []string
-}
-
-type Search struct {
-	Graph map[string]Valve
-}
-
-func (s Search) Path(start, goal string) (path []Valve) {
-	stack := make([]Node, 0, len(s.Graph))
-	stack = append(stack, Node{Name: start, Path: make([]string, 0, len(s.Graph))})
-
-	visited := make(map[string]bool)
-	for len(stack) > 0 {
-		current := stack[0]
-		stack = stack[1:]
-
-		if current.Name == goal {
-			path = make([]Valve, 0, len(current.Path))
-			for _, name := range current.Path {
-				path = append(path, s.Graph[name])
-			}
-			return
-		}
-
-		if _, found := visited[current.Name]; found {
-			continue
-		}
-		visited[current.Name] = true
-
-		current.Path = append(current.Path, current.Name)
-
-		for _, to := range s.Graph[current.Name].TunnelsTo {
-			node := Node{Name: to, Path: make([]string, len(current.Path))}
-			copy(node.Path, current.Path)
-			stack = append(stack, node)
-		}
-	}
-	return
-}
-
 type Route struct {
 	Open      []string
 	Closed    []string
@@ -86,26 +49,33 @@ func (s Solution) Files() embed.FS {
 func (s *Solution) Parse(r io.Reader) (err error) {
 	s.Valves = make([]Valve, 0, 64)
 	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
 		// Valve AA has flow rate=0; tunnels lead to valves DD, II, BB
-		fields := strings.FieldsFunc(line, func(r rune) bool {
+		fields := util.FieldsFunc(line, func(r rune) bool {
 			return r == ' ' || r == '=' || r == ',' || r == ';'
 		})
-		rate, err := strconv.Atoi(fields[5])
+		if len(fields) < 11 || fields[0].Text != "Valve" {
+			return util.Errorf(lineNo, 0, line, "expected valve, flow rate and tunnels")
+		}
+		rate, err := util.Atoi(lineNo, fields[5].Col, fields[5].Text)
 		if err != nil {
 			return err
 		}
 		s.Valves = append(s.Valves, Valve{
-			Name:      fields[1],
+			Name:      fields[1].Text,
 			FlowRate:  rate,
-			TunnelsTo: fields[10:],
+			TunnelsTo: util.Texts(fields[10:]),
 		})
 	}
-	return
+	return scanner.Err()
+}
+
+func (s Solution) Part1(w io.Writer) error {
+	return s.Part1Context(context.Background(), w)
 }
 
-func (s Solution) Part1(w io.Writer) (err error) {
+func (s Solution) Part1Context(ctx context.Context, w io.Writer) (err error) {
 	const TimeLimit = 30
 
 	graph := make(map[string]Valve)
@@ -118,15 +88,27 @@ func (s Solution) Part1(w io.Writer) (err error) {
 	}
 
 	routes := make([]Route, 0, 1024)
-	search := Search{
-		Graph: graph,
+	// Every route hops between pressurized valves, so work out how far each
+	// valve is from every other up front
+	names := make([]string, 0, len(s.Valves))
+	for _, valve := range s.Valves {
+		names = append(names, valve.Name)
 	}
+	tunnels := search.FloydWarshall(names, func(name string) (edges []search.Edge[string]) {
+		for _, to := range graph[name].TunnelsTo {
+			edges = append(edges, search.Edge[string]{To: to, Cost: 1})
+		}
+		return
+	})
 
 	// Initial routes from AA
 	for _, name := range pressurized {
 		valve := graph[name]
-		path := search.Path("AA", name)
-		timeTaken := len(path) + 1
+		distance, ok := tunnels.Cost("AA", name)
+		if !ok {
+			return fmt.Errorf("no tunnels from AA to %s", name)
+		}
+		timeTaken := distance + 1
 		route := Route{
 			Open:      make([]string, 0, len(pressurized)-1),
 			Closed:    []string{name},
@@ -147,13 +129,19 @@ func (s Solution) Part1(w io.Writer) (err error) {
 	stack := make([]Route, 0, 1024)
 	stack = append(stack, routes...)
 	for len(stack) > 0 {
+		if err = ctx.Err(); err != nil {
+			return
+		}
 		current := stack[0]
 		stack = stack[1:]
 
 		start := current.Closed[len(current.Closed)-1]
 		for _, open := range current.Open {
-			path := search.Path(start, open)
-			timeTaken := current.TimeTaken + len(path) + 1
+			distance, ok := tunnels.Cost(start, open)
+			if !ok {
+				return fmt.Errorf("no tunnels from %s to %s", start, open)
+			}
+			timeTaken := current.TimeTaken + distance + 1
 			route := Route{
 				Open: make([]string, 0, len(current.Open)-1),
 				// Closed:    append(current.Closed, open),
@@ -185,7 +173,10 @@ func (s Solution) Part1(w io.Writer) (err error) {
 	return
 }
 
-func (s Solution) Part2(w io.Writer) (err error) {
-	fmt.Fprintf(w, "Part 2: %d\n", 0)
-	return
+func (s Solution) Part2(w io.Writer) error {
+	return s.Part2Context(context.Background(), w)
+}
+
+func (s Solution) Part2Context(ctx context.Context, w io.Writer) (err error) {
+	return util.ErrUnsolved
 }
@@ -2,12 +2,15 @@ package tetris
 
 import (
 	"bufio"
+	"context"
 	"embed"
 	"fmt"
 	"io"
 	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/geom"
 )
 
 const (
@@ -20,13 +23,14 @@ const (
 //go:embed *.txt
 var Files embed.FS
 
-var _ util.Solution = new(Solution)
+var _ util.Cancellable = new(Solution)
 
-type Point struct {
-	X int
-	Y int
+func init() {
+	solutions.Register(solutions.Adapt(2022, 17, "tetris", new(Solution)))
 }
 
+type Point = geom.Point
+
 type Rock struct {
 	Name   string
 	Fill   byte
@@ -51,8 +55,6 @@ func NewArena() (a *Arena) {
 }
 
 func (a *Arena) Apply(p *Position) {
-	// rowsNeeded := 0
-	// fmt.Printf("%d - %d + %d = %d\n", p.Point.Y, a.Height(), p.Rock.Height, p.Point.Y-a.Height()+p.Rock.Height)
 	for i := a.Height(); i <= p.Point.Y; i++ {
 		row := [ArenaWidth]byte{}
 		for r := range row {
@@ -63,8 +65,6 @@ func (a *Arena) Apply(p *Position) {
 	for _, point := range p.Rock.Shape {
 		x := p.Point.X + point.X
 		y := p.Point.Y - point.Y
-		// fmt.Printf("rows: %d; (%d, %d)\n", len(a.Rows), x, y)
-		// a.Rows[y][x] = p.Rock.Fill
 		a.Rows[y][x] = RockSegment
 	}
 }
@@ -201,17 +201,33 @@ func (s *Solution) Parse(r io.Reader) (err error) {
 			},
 		},
 	}
+	s.Pattern = nil
 	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
-		s.Pattern = scanner.Bytes()
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		for i, jet := range scanner.Text() {
+			if jet != '<' && jet != '>' {
+				return util.Errorf(lineNo, i+1, string(jet), "expected < or >")
+			}
+		}
+		s.Pattern = append(s.Pattern[:0], scanner.Bytes()...)
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	if len(s.Pattern) == 0 {
+		return fmt.Errorf("no jet pattern")
 	}
 	return
 }
 
-func (s Solution) DropRocks(num int) (arena *Arena) {
+// DropRocks gives up with ctx.Err() if ctx is done before every rock lands
+func (s Solution) DropRocks(ctx context.Context, num int) (arena *Arena, err error) {
 	var moveCounter int
 	arena = NewArena()
 	for n := 0; n < num; n++ {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
 		rock := s.Rocks[n%len(s.Rocks)]
 		position := &Position{
 			Rock:  rock,
@@ -235,81 +251,26 @@ func (s Solution) DropRocks(num int) (arena *Arena) {
 		}
 		arena.Apply(position)
 	}
-	// return arena.Height()
 	return
 }
 
-func (s Solution) Part1(w io.Writer) (err error) {
-	arena := s.DropRocks(2022)
+func (s Solution) Part1(w io.Writer) error {
+	return s.Part1Context(context.Background(), w)
+}
+
+func (s Solution) Part1Context(ctx context.Context, w io.Writer) (err error) {
+	arena, err := s.DropRocks(ctx, 2022)
+	if err != nil {
+		return
+	}
 	fmt.Fprintf(w, "Part 1: %d\n", arena.Height())
 	return
 }
 
-func (s Solution) Part2(w io.Writer) (err error) {
-	drop := 1000000000000
-	// Turns out len(pattern) and len(rocks) are prime so LCM is easy
-	// lcm := len(s.Pattern) * len(s.Rocks)
-	// stacks := drop / lcm
-	// remain := drop % lcm
-	// stacksHeight := s.DropRocks(lcm)
-	// remainHeight := s.DropRocks(remain)
-
-	// fmt.Printf("LCM: %d; Stacks: %d; Remain: %d; Stack Height: %d; Remain Height: %d\n",
-	// 	lcm,
-	// 	stacks,
-	// 	remain,
-	// 	stacksHeight,
-	// 	remainHeight,
-	// )
-
-	// for n := 0; n < 5; n++ {
-	// 	drop := remain + lcm*n
-	// 	height := s.DropRocks(drop)
-	// 	fmt.Printf("%d + %d * %d = %6d - %6d\n", remain, lcm, n, drop, height)
-	// }
-
-	// for i := 1; i < 100000; i++ {
-	// 	single := s.DropRocks(i)
-	// 	for _, n := range []int{2, 3, 4, 5} {
-	// 		height := s.DropRocks(i * n)
-	// 		if height%single > 0 {
-	// 			break
-	// 		}
-	// 		fmt.Printf("%d * %d: %6d -> %6d %6d\n", i, n, i*n, height, height%single)
-	// 	}
-	// }
-
-	// arena := s.DropRocks(10000)
-	// rows := arena.Rows
-	// maxNum := 0
-	// for num := 100; num < 1000; num++ {
-	// 	gotMatch := false
-	// 	for skip := 0; skip < len(rows)-num; skip++ {
-	// 		search := rows[skip : skip+num]
-	// 		for i := skip + num; i < len(rows)-num; i++ {
-	// 			match := 0
-	// 			for s, row := range search {
-	// 				if !bytes.Equal(row[:], rows[i+s][:]) {
-	// 					break
-	// 				}
-	// 				match++
-	// 			}
-	// 			if match == num {
-	// 				maxNum = num
-	// 				gotMatch = true
-	// 				break
-	// 			}
-	// 		}
-	// 	}
-	// 	if gotMatch && maxNum > 0 {
-	// 		fmt.Println("Max Num:", maxNum)
-	// 	}
-	// 	if !gotMatch && maxNum > 0 {
-	// 		break
-	// 	}
-	// }
-	// fmt.Println("Max Num:", maxNum)
+func (s Solution) Part2(w io.Writer) error {
+	return s.Part2Context(context.Background(), w)
+}
 
-	fmt.Fprintf(w, "Part 2: %d\n", drop)
-	return
+func (s Solution) Part2Context(ctx context.Context, w io.Writer) (err error) {
+	return util.ErrUnsolved
 }
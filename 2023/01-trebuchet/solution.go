@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
 )
 
@@ -14,6 +15,10 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
+func init() {
+	solutions.Register(solutions.Adapt(2023, 1, "trebuchet", new(Solution)))
+}
+
 type Solution struct {
 	Lines [][]byte
 }
@@ -5,9 +5,9 @@ import (
 	"embed"
 	"fmt"
 	"io"
-	"strconv"
 	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
 )
 
@@ -16,6 +16,10 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
+func init() {
+	solutions.Register(solutions.Adapt(2023, 2, "cube conundrum", new(Solution)))
+}
+
 type Set struct {
 	Red   int
 	Green int
@@ -27,37 +31,40 @@ type Game struct {
 	Sets []Set
 }
 
-func NewGame(line string) (g Game) {
-	var err error
-	colonIdx := strings.IndexByte(line, ':')
-	if colonIdx < 0 {
-		panic("could not find colon! " + line)
+func NewGame(line string) (g Game, err error) {
+	game, sets, ok := strings.Cut(line, ": ")
+	if !ok || !strings.HasPrefix(game, "Game ") {
+		return g, util.Errorf(0, 1, game, "expected Game N:")
 	}
 
-	g.Id, err = strconv.Atoi(line[5:colonIdx])
-	if err != nil {
-		panic("could not atoi: " + line[5:colonIdx])
+	if g.Id, err = util.Atoi(0, 6, game[5:]); err != nil {
+		return
 	}
 
-	sets := strings.Split(line[colonIdx+2:], "; ")
-	g.Sets = make([]Set, len(sets))
-	for i, set := range sets {
-		cubes := strings.Split(set, ", ")
-		for _, cube := range cubes {
-			spaceIdx := strings.IndexByte(cube, ' ')
-			num, err := strconv.Atoi(cube[:spaceIdx])
+	for _, set := range util.Split(sets, "; ") {
+		var parsed Set
+		for _, cube := range util.Split(set.Text, ", ") {
+			col := len(game) + 2 + set.Col + cube.Col - 1
+			count, color, ok := strings.Cut(cube.Text, " ")
+			if !ok {
+				return g, util.Errorf(0, col, cube.Text, "expected count and color")
+			}
+			num, err := util.Atoi(0, col, count)
 			if err != nil {
-				panic("could not parse cube count: " + err.Error())
+				return g, err
 			}
-			switch cube[spaceIdx+1:] {
+			switch color {
 			case "red":
-				g.Sets[i].Red = num
+				parsed.Red = num
 			case "green":
-				g.Sets[i].Green = num
+				parsed.Green = num
 			case "blue":
-				g.Sets[i].Blue = num
+				parsed.Blue = num
+			default:
+				return g, util.Errorf(0, col+len(count)+1, color, "expected red, green or blue")
 			}
 		}
+		g.Sets = append(g.Sets, parsed)
 	}
 	return
 }
@@ -74,10 +81,14 @@ func (s *Solution) Parse(r io.Reader) (err error) {
 	s.Games = make([]Game, 0, 100)
 
 	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
-		s.Games = append(s.Games, NewGame(scanner.Text()))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		game, err := NewGame(scanner.Text())
+		if err != nil {
+			return util.AtLine(err, lineNo)
+		}
+		s.Games = append(s.Games, game)
 	}
-	return
+	return scanner.Err()
 }
 
 func (s Solution) Part1(w io.Writer) (err error) {
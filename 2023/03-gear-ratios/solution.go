@@ -8,6 +8,7 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
 )
 
@@ -16,6 +17,10 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
+func init() {
+	solutions.Register(solutions.Adapt(2023, 3, "gear ratios", new(Solution)))
+}
+
 type Solution struct {
 	Chars [][]byte
 }
@@ -24,16 +29,20 @@ func (s Solution) Files() embed.FS {
 	return Files
 }
 
+// The schematic must be rectangular for the neighbours of a number to exist
 func (s *Solution) Parse(r io.Reader) (err error) {
 	s.Chars = make([][]byte, 0, 140)
 	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		data := scanner.Bytes()
+		if len(s.Chars) > 0 && len(data) != len(s.Chars[0]) {
+			return util.Errorf(lineNo, 0, "", "expected %d characters, got %d", len(s.Chars[0]), len(data))
+		}
 		line := make([]byte, len(data))
 		copy(line, data)
 		s.Chars = append(s.Chars, line)
 	}
-	return
+	return scanner.Err()
 }
 
 func (s Solution) Nums() (nums [][][2]int) {
@@ -7,9 +7,9 @@ import (
 	"io"
 	"math"
 	"slices"
-	"strconv"
 	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
 )
 
@@ -18,11 +18,40 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
+func init() {
+	solutions.Register(solutions.Adapt(2023, 4, "scratchcards", new(Solution)))
+}
+
 type Ticket struct {
 	Revealed []int
 	Winning  []int
 }
 
+// Matches counts the revealed numbers that are also winning numbers; both
+// lists are sorted
+func (t Ticket) Matches() (matches int) {
+	// just aliases to cut down on typing
+	rev := t.Revealed
+	win := t.Winning
+	for r, w := 0, 0; r < len(rev) && w < len(win); {
+		if rev[r] == win[w] {
+			matches++
+			r++
+			w++
+			continue
+		}
+		if rev[r] > win[w] {
+			w++
+			continue
+		}
+		if win[w] > rev[r] {
+			r++
+			continue
+		}
+	}
+	return
+}
+
 type Solution struct {
 	Tickets []Ticket
 }
@@ -34,25 +63,34 @@ func (s Solution) Files() embed.FS {
 func (s *Solution) Parse(r io.Reader) (err error) {
 	s.Tickets = make([]Ticket, 0, 250)
 	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
-		colon := strings.IndexByte(line, ':')
-		parts := strings.Split(line[colon+1:], "|")
+		card, numbers, ok := strings.Cut(line, ":")
+		if !ok {
+			return util.Errorf(lineNo, 0, line, "expected Card N:")
+		}
+		left, right, ok := strings.Cut(numbers, "|")
+		if !ok {
+			return util.Errorf(lineNo, 0, line, "expected revealed | winning numbers")
+		}
 
-		revealed := strings.Fields(strings.TrimSpace(parts[0]))
-		winning := strings.Fields(strings.TrimSpace(parts[1]))
+		// Columns of the numbers on either side of the separator
+		leftCol := len(card) + 1
+		rightCol := leftCol + len(left) + 1
+		revealed := util.Fields(left)
+		winning := util.Fields(right)
 
 		ticket := Ticket{
 			Revealed: make([]int, len(revealed)),
 			Winning:  make([]int, len(winning)),
 		}
 		for i, r := range revealed {
-			if ticket.Revealed[i], err = strconv.Atoi(r); err != nil {
+			if ticket.Revealed[i], err = util.Atoi(lineNo, leftCol+r.Col, r.Text); err != nil {
 				return
 			}
 		}
 		for i, w := range winning {
-			if ticket.Winning[i], err = strconv.Atoi(w); err != nil {
+			if ticket.Winning[i], err = util.Atoi(lineNo, rightCol+w.Col, w.Text); err != nil {
 				return
 			}
 		}
@@ -61,32 +99,23 @@ func (s *Solution) Parse(r io.Reader) (err error) {
 		slices.Sort[[]int](ticket.Winning)
 		s.Tickets = append(s.Tickets, ticket)
 	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+
+	// Cards never win copies of cards past the end of the table
+	for i, ticket := range s.Tickets {
+		if i+ticket.Matches() >= len(s.Tickets) {
+			return util.Errorf(i+1, 0, "", "card wins %d copies past the last card", i+ticket.Matches()-len(s.Tickets)+1)
+		}
+	}
 	return
 }
 
 func (s Solution) Part1(w io.Writer) (err error) {
 	var sum int
 	for _, ticket := range s.Tickets {
-		var matches int
-		// just aliases to cut down on typing
-		rev := ticket.Revealed
-		win := ticket.Winning
-		for r, w := 0, 0; r < len(rev) && w < len(win); {
-			if rev[r] == win[w] {
-				matches++
-				r++
-				w++
-				continue
-			}
-			if rev[r] > win[w] {
-				w++
-				continue
-			}
-			if win[w] > rev[r] {
-				r++
-				continue
-			}
-		}
+		matches := ticket.Matches()
 		if matches == 0 {
 			continue
 		}
@@ -100,25 +129,7 @@ func (s Solution) Part2(w io.Writer) (err error) {
 	var sum int
 	matches := make([]int, len(s.Tickets))
 	for i, ticket := range s.Tickets {
-		// just aliases to cut down on typing
-		rev := ticket.Revealed
-		win := ticket.Winning
-		for r, w := 0, 0; r < len(rev) && w < len(win); {
-			if rev[r] == win[w] {
-				matches[i]++
-				r++
-				w++
-				continue
-			}
-			if rev[r] > win[w] {
-				w++
-				continue
-			}
-			if win[w] > rev[r] {
-				r++
-				continue
-			}
-		}
+		matches[i] = ticket.Matches()
 	}
 
 	copies := make([]int, len(matches))
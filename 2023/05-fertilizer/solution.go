@@ -1,16 +1,14 @@
 package fertilizer
 
 import (
-	"bufio"
 	"embed"
 	"fmt"
 	"io"
-	"math"
-	"slices"
-	"strconv"
-	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/interval"
+	"github.com/jbaikge/advent-of-code/util/parse"
 )
 
 //go:embed *.txt
@@ -18,133 +16,15 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
-type Bound struct {
-	Destination int
-	Source      int
-	Length      int
-}
-
-func (b Bound) Lower() int {
-	return b.Source
-}
-
-func (b Bound) Upper() int {
-	return b.Source + b.Length - 1
-}
-
-func (b Bound) Delta() int {
-	return b.Destination - b.Source
-}
-
-func (b Bound) Overlaps(a Bound) bool {
-	// b fully contains a
-	// b ... a ... a ... b
-	if b.Lower() <= a.Lower() && b.Upper() >= a.Upper() {
-		return true
-	}
-	// b starts before a and there is overlap
-	// b ... a ... b ... a
-	if b.Lower() <= a.Lower() && b.Upper() >= a.Lower() && b.Upper() <= a.Upper() {
-		return true
-	}
-	// b starts after a and there is overlap
-	// a ... b ... a ... b
-	if b.Lower() >= a.Lower() && b.Lower() <= a.Upper() && b.Upper() >= a.Upper() {
-		return true
-	}
-	return false
-}
-
-func (b Bound) Split(a Bound) (chunks []Bound) {
-	chunks = make([]Bound, 1, 2)
-	chunks[0] = a
-	if b.Upper() < a.Upper() {
-		chunks[0].Length = b.Upper() - chunks[0].Lower() + 1
-		chunks = append(chunks, Bound{
-			Source: b.Upper() + 1,
-			Length: a.Length - chunks[0].Length,
-		})
-	}
-	return
-}
-
-func (b Bound) String() string {
-	return fmt.Sprintf("<%d, %d>", b.Lower(), b.Upper())
+func init() {
+	solutions.Register(solutions.Adapt(2023, 5, "fertilizer", new(Solution)))
 }
 
+// Range is one of the almanac's maps, moving numbers from one category to
+// the next
 type Range struct {
-	Name   string
-	Bounds []Bound
-}
-
-func (r *Range) AddCaps() {
-	r.Sort()
-
-	if r.Bounds[0].Source > 0 {
-		r.Bounds = append([]Bound{
-			{
-				Destination: 0,
-				Source:      0,
-				Length:      r.Bounds[0].Source,
-			},
-		}, r.Bounds...)
-	}
-
-	lastBound := r.Bounds[len(r.Bounds)-1]
-	if lastBound.Upper() < math.MaxUint32 {
-		r.Bounds = append(r.Bounds, Bound{
-			Destination: lastBound.Upper(),
-			Source:      lastBound.Upper(),
-			Length:      math.MaxUint32 - lastBound.Upper(),
-		})
-	}
-
-}
-
-func (r *Range) Append(b Bound) {
-	r.Bounds = append(r.Bounds, b)
-}
-
-func (r *Range) FillGaps() {
-	for i, current := range r.Bounds[1:] {
-		prev := r.Bounds[i]
-		if prev.Upper()+1 == current.Lower() {
-			continue
-		}
-		filler := Bound{
-			Source:      prev.Upper() + 1,
-			Length:      current.Lower() - prev.Upper() - 1,
-			Destination: prev.Upper() + 1,
-		}
-		// Expand the slice by 1
-		r.Bounds = append(r.Bounds[:i+1], r.Bounds[i:]...)
-		// i+1 because i is ahead by 1 with the slice operation
-		r.Bounds[i+1] = filler
-	}
-}
-
-func (r *Range) Sort() {
-	slices.SortFunc[[]Bound, Bound](r.Bounds, func(a, b Bound) int {
-		return a.Source - b.Source
-	})
-}
-
-func (r Range) Destinations(b Bound) (destinations []Bound) {
-	destinations = make([]Bound, 0, 16)
-	for _, bound := range r.Bounds {
-		if !bound.Overlaps(b) {
-			continue
-		}
-		chunks := bound.Split(b)
-		destinations = append(destinations, Bound{
-			Source: chunks[0].Source + bound.Delta(),
-			Length: chunks[0].Length,
-		})
-		if len(chunks) == 2 {
-			b = chunks[1]
-		}
-	}
-	return
+	Name    string
+	Mapping interval.Mapping
 }
 
 type Solution struct {
@@ -152,18 +32,12 @@ type Solution struct {
 	Ranges []Range
 }
 
-func (s Solution) Location(seed Bound) (locs []Bound) {
-	destinations := []Bound{seed}
-
+// Locations follows every seed through each map in turn
+func (s Solution) Locations(seeds interval.Set) interval.Set {
 	for _, r := range s.Ranges {
-		sources := make([]Bound, len(destinations))
-		copy(sources, destinations)
-		destinations = destinations[:0]
-		for _, source := range sources {
-			destinations = append(destinations, r.Destinations(source)...)
-		}
+		seeds = r.Mapping.Apply(seeds)
 	}
-	return destinations
+	return seeds
 }
 
 func (s Solution) Files() embed.FS {
@@ -171,83 +45,81 @@ func (s Solution) Files() embed.FS {
 }
 
 func (s *Solution) Parse(r io.Reader) (err error) {
-	scanner := bufio.NewScanner(r)
-	var target *Range
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		// Skip blank lines
-		if line == "" {
-			continue
-		}
+	s.Seeds, s.Ranges = nil, nil
+	lines, err := parse.ReadLines(r)
+	if err != nil {
+		return
+	}
 
-		fields := strings.Fields(line)
-		switch {
-		case fields[0] == "seeds:":
+	// The seeds come first, then one block per map
+	for i, block := range parse.Paragraphs(lines) {
+		header := block[0]
+		if i == 0 {
+			fields := header.Fields()
+			if len(block) != 1 || fields[0].Text != "seeds:" {
+				return header.Errorf(0, header.Text, "expected seeds")
+			}
 			s.Seeds = make([]int, len(fields[1:]))
-			for i, v := range fields[1:] {
-				if s.Seeds[i], err = strconv.Atoi(v); err != nil {
+			for j, v := range fields[1:] {
+				if s.Seeds[j], err = header.Atoi(v); err != nil {
 					return
 				}
 			}
-		case fields[1] == "map:":
-			s.Ranges = append(s.Ranges, Range{
-				Name:   fields[0],
-				Bounds: make([]Bound, 0, 16),
-			})
-			target = &s.Ranges[len(s.Ranges)-1]
-		default:
-			if target == nil {
-				return fmt.Errorf("target is nil")
-			}
-			var b Bound
-			if b.Destination, err = strconv.Atoi(fields[0]); err != nil {
-				return
-			}
-			if b.Source, err = strconv.Atoi(fields[1]); err != nil {
-				return
-			}
-			if b.Length, err = strconv.Atoi(fields[2]); err != nil {
+			continue
+		}
+
+		var name string
+		if err = header.Scan("%s map:", &name); err != nil {
+			return
+		}
+		if len(block) == 1 {
+			return header.Errorf(0, header.Text, "%s map has no bounds", name)
+		}
+		offsets := make([]interval.Offset, 0, len(block)-1)
+		for _, line := range block[1:] {
+			var destination, source, length int
+			if err = line.Scan("%d %d %d", &destination, &source, &length); err != nil {
 				return
 			}
-			target.Append(b)
+			offsets = append(offsets, interval.Offset{
+				Interval: interval.FromLength(source, length),
+				Delta:    destination - source,
+			})
 		}
+		mapping := Range{Name: name}
+		if mapping.Mapping, err = interval.NewMapping(offsets...); err != nil {
+			return util.AtLine(fmt.Errorf("%s map: %w", name, err), header.No)
+		}
+		s.Ranges = append(s.Ranges, mapping)
 	}
 
-	// Add caps
-	// Also fill in gaps!
-	for i := range s.Ranges {
-		s.Ranges[i].AddCaps()
-		s.Ranges[i].FillGaps()
+	if len(s.Seeds) == 0 {
+		return fmt.Errorf("no seeds")
 	}
-
 	return
 }
 
 func (s Solution) Part1(w io.Writer) (err error) {
-	min := math.MaxUint32
-	for _, source := range s.Seeds {
-		seed := Bound{Source: source, Length: 1}
-		for _, loc := range s.Location(seed) {
-			if loc.Lower() < min {
-				min = loc.Lower()
-			}
-		}
+	seeds := make([]interval.Interval, len(s.Seeds))
+	for i, seed := range s.Seeds {
+		seeds[i] = interval.New(seed, seed)
 	}
-	fmt.Fprintf(w, "Part 1: %d\n", min)
+	fmt.Fprintf(w, "Part 1: %d\n", s.Locations(interval.Merge(seeds...)).Min())
 	return
 }
 
 func (s Solution) Part2(w io.Writer) (err error) {
-	min := math.MaxUint32
+	if len(s.Seeds)%2 != 0 {
+		return fmt.Errorf("expected seed starts and lengths in pairs, got %d numbers", len(s.Seeds))
+	}
+	seeds := make([]interval.Interval, 0, len(s.Seeds)/2)
 	for i := 0; i < len(s.Seeds); i += 2 {
-		seed := Bound{Source: s.Seeds[i], Length: s.Seeds[i+1]}
-		for _, loc := range s.Location(seed) {
-			if loc.Lower() < min {
-				min = loc.Lower()
-			}
-		}
+		seeds = append(seeds, interval.FromLength(s.Seeds[i], s.Seeds[i+1]))
+	}
+	locations := s.Locations(interval.Merge(seeds...))
+	if locations.Empty() {
+		return fmt.Errorf("no seeds")
 	}
-	fmt.Fprintf(w, "Part 2: %d\n", min)
+	fmt.Fprintf(w, "Part 2: %d\n", locations.Min())
 	return
 }
@@ -6,9 +6,8 @@ import (
 	"fmt"
 	"io"
 	"math"
-	"strconv"
-	"strings"
 
+	"github.com/jbaikge/advent-of-code/solutions"
 	"github.com/jbaikge/advent-of-code/util"
 )
 
@@ -17,6 +16,10 @@ var Files embed.FS
 
 var _ util.Solution = new(Solution)
 
+func init() {
+	solutions.Register(solutions.Adapt(2023, 6, "wait for it", new(Solution)))
+}
+
 type Race struct {
 	Time     int
 	Distance int
@@ -49,23 +52,30 @@ func (s *Solution) Parse(r io.Reader) (err error) {
 
 	// Parse Times
 	scanner.Scan()
-	timeNums := strings.Fields(scanner.Text())[1:]
-	s.Races = make([]Race, len(timeNums))
-	for i, num := range timeNums {
-		if s.Races[i].Time, err = strconv.Atoi(num); err != nil {
+	timeNums := util.Fields(scanner.Text())
+	if len(timeNums) < 2 || timeNums[0].Text != "Time:" {
+		return util.Errorf(1, 0, scanner.Text(), "expected times")
+	}
+	s.Races = make([]Race, len(timeNums)-1)
+	for i, num := range timeNums[1:] {
+		if s.Races[i].Time, err = util.Atoi(1, num.Col, num.Text); err != nil {
 			return
 		}
 	}
 
 	// Parse distances
 	scanner.Scan()
-	for i, num := range strings.Fields(scanner.Text())[1:] {
-		if s.Races[i].Distance, err = strconv.Atoi(num); err != nil {
+	distNums := util.Fields(scanner.Text())
+	if len(distNums) != len(timeNums) || distNums[0].Text != "Distance:" {
+		return util.Errorf(2, 0, scanner.Text(), "expected a distance for each time")
+	}
+	for i, num := range distNums[1:] {
+		if s.Races[i].Distance, err = util.Atoi(2, num.Col, num.Text); err != nil {
 			return
 		}
 	}
 
-	return
+	return scanner.Err()
 }
 
 // Observation: The numbers make a curve up and then down
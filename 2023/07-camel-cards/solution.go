@@ -4,12 +4,10 @@ import (
 	"bufio"
 	"bytes"
 	_ "embed"
-	"log"
 	"slices"
-	"strconv"
-	"strings"
 
 	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
 const (
@@ -45,7 +43,7 @@ var cardMap = map[byte]int{
 }
 
 func init() {
-	solutions.Register(new(Solution))
+	solutions.Register(solutions.WithContext(new(Solution)))
 }
 
 type Hand struct {
@@ -93,7 +91,6 @@ func (hand Hand) Type() int {
 				return TypeFullHouse
 			}
 		}
-		log.Fatalf("len = 2 but not 4 of a kind or full house: %v", hand.Cards)
 	case 3:
 		pairs := 0
 		for _, count := range counts {
@@ -107,7 +104,6 @@ func (hand Hand) Type() int {
 		if pairs == 2 {
 			return TypeTwoPair
 		}
-		log.Fatalf("len = 3 but not 3 of a kind: %v", hand.Cards)
 	case 4:
 		return TypeOnePair
 	}
@@ -208,8 +204,8 @@ func (*Solution) Meta() solutions.Meta {
 			{
 				Name:    "Test",
 				Input:   testData,
-				Expect1: 6440,
-				Expect2: 5905,
+				Expect1: solutions.Int(6440),
+				Expect2: solutions.Int(5905),
 			},
 			{
 				Name:  "Input",
@@ -222,20 +218,29 @@ func (*Solution) Meta() solutions.Meta {
 func (s *Solution) Parse(data []byte) (err error) {
 	s.Hands = make([]Hand, 0, 1000)
 	scanner := bufio.NewScanner(bytes.NewReader(data))
-	for scanner.Scan() {
+	for lineNo := 1; scanner.Scan(); lineNo++ {
 		var bid int
 
-		fields := strings.Fields(scanner.Text())
-		bid, err = strconv.Atoi(fields[1])
+		fields := util.Fields(scanner.Text())
+		if len(fields) != 2 || len(fields[0].Text) != 5 {
+			return util.Errorf(lineNo, 0, scanner.Text(), "expected five cards and a bid")
+		}
+		cards := fields[0]
+		for i, card := range cards.Text {
+			if _, ok := cardMap[cards.Text[i]]; !ok {
+				return util.Errorf(lineNo, cards.Col+i, string(card), "unknown card")
+			}
+		}
+		bid, err = util.Atoi(lineNo, fields[1].Col, fields[1].Text)
 		if err != nil {
 			return
 		}
-		s.Hands = append(s.Hands, NewHand(fields[0], bid))
+		s.Hands = append(s.Hands, NewHand(cards.Text, bid))
 	}
-	return
+	return scanner.Err()
 }
 
-func (s *Solution) Part1() (answer int, err error) {
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
 	hands := make([]Hand, len(s.Hands))
 	copy(hands, s.Hands)
 
@@ -243,13 +248,14 @@ func (s *Solution) Part1() (answer int, err error) {
 		return a.Less(b)
 	})
 
+	winnings := 0
 	for i, hand := range hands {
-		answer += (i + 1) * hand.Bid
+		winnings += (i + 1) * hand.Bid
 	}
-	return
+	return solutions.Int(winnings), nil
 }
 
-func (s *Solution) Part2() (answer int, err error) {
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
 	hands := make([]Hand, len(s.Hands))
 	copy(hands, s.Hands)
 
@@ -257,8 +263,9 @@ func (s *Solution) Part2() (answer int, err error) {
 		return a.WildLess(b)
 	})
 
+	winnings := 0
 	for i, hand := range hands {
-		answer += (i + 1) * hand.Bid
+		winnings += (i + 1) * hand.Bid
 	}
-	return
+	return solutions.Int(winnings), nil
 }
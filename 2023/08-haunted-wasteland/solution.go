@@ -4,8 +4,12 @@ import (
 	"bufio"
 	"bytes"
 	_ "embed"
+	"errors"
+	"fmt"
 
 	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+	"github.com/jbaikge/advent-of-code/util/numtheory"
 )
 
 //go:embed test1.txt
@@ -21,7 +25,7 @@ var test3Data []byte
 var inputData []byte
 
 func init() {
-	solutions.Register(new(Solution))
+	solutions.Register(solutions.WithContext(new(Solution)))
 }
 
 type Node struct {
@@ -43,20 +47,17 @@ func (*Solution) Meta() solutions.Meta {
 			{
 				Name:    "Test 1",
 				Input:   test1Data,
-				Expect1: 2,
-				Expect2: 0,
+				Expect1: solutions.Int(2),
 			},
 			{
 				Name:    "Test 2",
 				Input:   test2Data,
-				Expect1: 6,
-				Expect2: 0,
+				Expect1: solutions.Int(6),
 			},
 			{
 				Name:    "Test 3",
 				Input:   test3Data,
-				Expect1: 0,
-				Expect2: 6,
+				Expect2: solutions.Int(6),
 			},
 			{
 				Name:  "Input",
@@ -72,24 +73,46 @@ func (s *Solution) Parse(data []byte) (err error) {
 	// First line contains instructions
 	scanner.Scan()
 	s.Instructions = scanner.Text()
+	if s.Instructions == "" {
+		return util.Errorf(1, 0, "", "expected instructions")
+	}
+	for i, r := range s.Instructions {
+		if r != 'L' && r != 'R' {
+			return util.Errorf(1, i+1, string(r), "expected L or R")
+		}
+	}
 
 	// Next line is blank
 	scanner.Scan()
 
 	// Remaining lines are nodes
 	s.Nodes = make(map[string]Node)
-	for scanner.Scan() {
+	for lineNo := 3; scanner.Scan(); lineNo++ {
 		line := scanner.Text()
+		// AAA = (BBB, CCC)
+		if len(line) != 16 || line[3:7] != " = (" || line[10:12] != ", " || line[15] != ')' {
+			return util.Errorf(lineNo, 0, line, "expected AAA = (BBB, CCC)")
+		}
 		s.Nodes[line[:3]] = Node{
 			Left:  line[7:10],
 			Right: line[12:15],
 		}
 	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
 
+	for name, node := range s.Nodes {
+		for _, next := range []string{node.Left, node.Right} {
+			if _, ok := s.Nodes[next]; !ok {
+				return fmt.Errorf("node %s leads to missing node %s", name, next)
+			}
+		}
+	}
 	return
 }
 
-func (s *Solution) Part1() (answer int, err error) {
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
 	key := "AAA"
 
 	// Skip test 3 data
@@ -97,80 +120,140 @@ func (s *Solution) Part1() (answer int, err error) {
 		return
 	}
 
-	idx := 0
-	for key != "ZZZ" {
-		instruction := s.Instructions[idx]
-		if instruction == 'L' {
-			key = s.Nodes[key].Left
-		} else {
-			key = s.Nodes[key].Right
-		}
-		answer++
-		idx++
-		if idx >= len(s.Instructions) {
-			idx = 0
-		}
+	steps := 0
+	for ; key != "ZZZ"; steps++ {
+		key = s.next(key, steps)
 	}
-	return
+	return solutions.Int(steps), nil
 }
 
-// Greatest Common Divisor
-// @see https://siongui.github.io/2017/06/03/go-find-lcm-by-gcd/
-func GCD(a int, b int) int {
-	for b != 0 {
-		t := b
-		b = a % b
-		a = t
+// next is where the instruction for this step leads from key
+func (s *Solution) next(key string, step int) string {
+	if s.Instructions[step%len(s.Instructions)] == 'L' {
+		return s.Nodes[key].Left
 	}
-	return a
+	return s.Nodes[key].Right
 }
 
-func LCM(a int, b int, nums ...int) int {
-	result := a * b / GCD(a, b)
+// Loop describes a ghost's walk. After Start steps the walk repeats every
+// Period steps, and Ends lists each step before Start+Period that lands on a
+// node ending in Z.
+type Loop struct {
+	Start  int
+	Period int
+	Ends   []int
+}
 
-	for _, num := range nums {
-		result = LCM(result, num)
+// loop walks from key until it comes back to a node it stood on at the same
+// point in the instructions. Only the first instruction is checked, so Start
+// may be a little later than the loop truly begins, which does no harm.
+func (s *Solution) loop(key string) Loop {
+	seen := make(map[string]int)
+	var ends []int
+	for step := 0; ; step++ {
+		if step%len(s.Instructions) == 0 {
+			if first, ok := seen[key]; ok {
+				return Loop{Start: first, Period: step - first, Ends: ends}
+			}
+			seen[key] = step
+		}
+		if key[2] == 'Z' {
+			ends = append(ends, step)
+		}
+		key = s.next(key, step)
 	}
+}
 
-	return result
+// OnEnd reports whether the ghost stands on a Z node after step steps
+func (l Loop) OnEnd(step int) bool {
+	if step >= l.Start {
+		step = l.Start + (step-l.Start)%l.Period
+	}
+	for _, end := range l.Ends {
+		if end == step {
+			return true
+		}
+	}
+	return false
 }
 
-func (s *Solution) Part2() (answer int, err error) {
-	counts := make(map[string]int)
-	keys := make([]string, 0, 6)
-	for key := range s.Nodes {
-		if key[2] == 'A' {
-			keys = append(keys, key)
-			counts[key] = 0
+// align finds the first step on which every ghost stands on a Z node. Before
+// the last ghost settles into its loop the walks are simply checked step by
+// step. After that each ghost is on a Z node only at certain steps of its
+// loop; each choice of one such step per ghost gives a set of congruences,
+// and the Chinese Remainder Theorem finds when they all hold at once.
+func align(loops []Loop) (step int, err error) {
+	settled := 0
+	for _, l := range loops {
+		if l.Start > settled {
+			settled = l.Start
+		}
+	}
+	for step = 0; step < settled; step++ {
+		all := true
+		for _, l := range loops {
+			all = all && l.OnEnd(step)
+		}
+		if all {
+			return
 		}
 	}
 
-	for _, key := range keys {
-		start := key
-		idx := 0
-		for key[2] != 'Z' {
-			instruction := s.Instructions[idx]
-			if instruction == 'L' {
-				key = s.Nodes[key].Left
-			} else {
-				key = s.Nodes[key].Right
+	choices := [][]numtheory.Congruence{nil}
+	for _, l := range loops {
+		var next [][]numtheory.Congruence
+		for _, end := range l.Ends {
+			if end < l.Start {
+				continue
 			}
-			counts[start]++
-			if idx++; idx >= len(s.Instructions) {
-				idx = 0
+			c := numtheory.Congruence{Rem: end, Mod: l.Period}
+			for _, choice := range choices {
+				// Capping the capacity makes append copy, so choices
+				// sharing a prefix never share storage
+				next = append(next, append(choice[:len(choice):len(choice)], c))
 			}
 		}
+		choices = next
 	}
 
-	nums := make([]int, 0, len(counts))
-	for _, count := range counts {
-		nums = append(nums, count)
+	step = -1
+	for _, choice := range choices {
+		c, err := numtheory.CRT(choice...)
+		if errors.Is(err, numtheory.ErrNoSolution) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		// The first solution may come before every ghost has settled
+		first := c.Rem
+		if first < settled {
+			first += (settled - first + c.Mod - 1) / c.Mod * c.Mod
+		}
+		if step < 0 || first < step {
+			step = first
+		}
 	}
-
-	if len(nums) > 2 {
-		answer = LCM(nums[0], nums[1], nums[2:]...)
-	} else if len(nums) == 2 {
-		answer = LCM(nums[0], nums[1])
+	if step < 0 {
+		return 0, fmt.Errorf("the ghosts never all reach a Z node together")
 	}
 	return
 }
+
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	var loops []Loop
+	for key := range s.Nodes {
+		if key[2] == 'A' {
+			loops = append(loops, s.loop(key))
+		}
+	}
+	if len(loops) == 0 {
+		return
+	}
+
+	step, err := align(loops)
+	if err != nil {
+		return
+	}
+	return solutions.Int(step), nil
+}
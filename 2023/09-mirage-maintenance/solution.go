@@ -4,10 +4,9 @@ import (
 	"bufio"
 	"bytes"
 	_ "embed"
-	"strconv"
-	"strings"
 
 	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
 )
 
 //go:embed test.txt
@@ -17,7 +16,7 @@ var testData []byte
 var inputData []byte
 
 func init() {
-	solutions.Register(new(Solution))
+	solutions.Register(solutions.WithContext(new(Solution)))
 }
 
 type Solution struct {
@@ -33,26 +32,27 @@ func (*Solution) Meta() solutions.Meta {
 			{
 				Name:    "Test 1",
 				Input:   []byte(`0 3 6 9 12 15`),
-				Expect1: 18,
-				Expect2: -3,
+				Expect1: solutions.Int(18),
+				Expect2: solutions.Int(-3),
 			},
 			{
 				Name:    "Test 2",
 				Input:   []byte(`1 3 6 10 15 21`),
-				Expect1: 28,
-				Expect2: 0,
+				Expect1: solutions.Int(28),
+				Expect2: solutions.Int(0),
 			},
 			{
 				Name:    "Test 3",
 				Input:   []byte(`10 13 16 21 30 45`),
-				Expect1: 68,
-				Expect2: 5,
+				Expect1: solutions.Int(68),
+				Expect2: solutions.Int(5),
 			},
 			{
 				Name:    "Test 4",
+				File:    "test.txt",
 				Input:   testData,
-				Expect1: 114,
-				Expect2: 2,
+				Expect1: solutions.Int(114),
+				Expect2: solutions.Int(2),
 			},
 			{
 				Name:  "Input",
@@ -66,11 +66,11 @@ func (s *Solution) Parse(data []byte) (err error) {
 	s.Sets = make([][]int, 0, 200)
 
 	scanner := bufio.NewScanner(bytes.NewReader(data))
-	for scanner.Scan() {
-		values := strings.Fields(scanner.Text())
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		values := util.Fields(scanner.Text())
 		set := make([]int, len(values))
 		for i, value := range values {
-			if set[i], err = strconv.Atoi(value); err != nil {
+			if set[i], err = util.Atoi(lineNo, value.Col, value.Text); err != nil {
 				return
 			}
 		}
@@ -80,18 +80,20 @@ func (s *Solution) Parse(data []byte) (err error) {
 	return
 }
 
-func (s *Solution) Part1() (answer int, err error) {
+func (s *Solution) Part1() (answer solutions.Answer, err error) {
+	sum := 0
 	for _, set := range s.Sets {
-		answer += FindNext(set)
+		sum += FindNext(set)
 	}
-	return
+	return solutions.Int(sum), nil
 }
 
-func (s *Solution) Part2() (answer int, err error) {
+func (s *Solution) Part2() (answer solutions.Answer, err error) {
+	sum := 0
 	for _, set := range s.Sets {
-		answer += FindPrev(set)
+		sum += FindPrev(set)
 	}
-	return
+	return solutions.Int(sum), nil
 }
 
 func Tree(nums []int) [][]int {
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/internal/vault"
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+var phaseNames = [3]string{"parse", "part 1", "part 2"}
+
+// BenchStats summarises the samples of one phase. Allocations and bytes are
+// averaged per run.
+type BenchStats struct {
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	P95    time.Duration `json:"p95_ns"`
+	Max    time.Duration `json:"max_ns"`
+	Allocs uint64        `json:"allocs_per_op"`
+	Bytes  uint64        `json:"bytes_per_op"`
+}
+
+// Bench holds the statistics of repeatedly parsing and solving one dataset.
+// When a phase fails, Failed names it and there are no statistics.
+type Bench struct {
+	Year    int        `json:"year"`
+	Problem int        `json:"problem"`
+	Name    string     `json:"name"`
+	Dataset string     `json:"dataset"`
+	Runs    int        `json:"runs"`
+	Parse   BenchStats `json:"parse"`
+	Part1   BenchStats `json:"part1"`
+	Part2   BenchStats `json:"part2"`
+	Failed  string     `json:"failed,omitempty"`
+	Error   string     `json:"error,omitempty"`
+}
+
+func (b Bench) Key() string {
+	return fmt.Sprintf("%d/%02d %s", b.Year, b.Problem, b.Dataset)
+}
+
+func (b Bench) Phases() [3]BenchStats {
+	return [3]BenchStats{b.Parse, b.Part1, b.Part2}
+}
+
+// Benchmark runs every dataset n times, each time against a fresh instance of
+// the solution. An extra untimed run first warms up caches and the heap.
+// Locked inputs are skipped. Each part gets at most timeout, or forever when
+// timeout is zero. A dataset that fails records the error in its Bench and
+// the rest carry on.
+func Benchmark(solution solutions.Solution, datas []solutions.Data, n int, timeout time.Duration) (benches []Bench) {
+	meta := solution.Meta()
+	benches = make([]Bench, 0, len(datas))
+	for _, data := range datas {
+		input, err := vault.Open(data.Input)
+		if errors.Is(err, vault.ErrLocked) {
+			continue
+		}
+
+		bench := Bench{
+			Year:    meta.Year,
+			Problem: meta.Problem,
+			Name:    meta.Name,
+			Dataset: data.Name,
+			Runs:    n,
+		}
+		if err != nil {
+			bench.Failed, bench.Error = phaseNames[0], err.Error()
+		} else {
+			bench.sample(solution, input, data.Filename(), n, timeout)
+		}
+		benches = append(benches, bench)
+	}
+	return
+}
+
+// sample fills in the statistics of b, or the phase that failed and why.
+// Parse errors are placed in filename.
+func (b *Bench) sample(solution solutions.Solution, input []byte, filename string, n int, timeout time.Duration) {
+	var samples [3][]time.Duration
+	var allocs, bytes [3]uint64
+	for i := 0; i <= n; i++ {
+		s := solutions.New(solution)
+		phases := [3]func() error{
+			func() error { return s.Parse(input) },
+			func() (err error) { _, _, err = runPart(s.Part1, timeout); return },
+			func() (err error) { _, _, err = runPart(s.Part2, timeout); return },
+		}
+		for p, phase := range phases {
+			elapsed, mallocs, total, err := measure(phase)
+			if p == 0 {
+				err = util.InFile(err, filename)
+			}
+			if err != nil {
+				b.Failed, b.Error = phaseNames[p], err.Error()
+				return
+			}
+			if i == 0 {
+				continue
+			}
+			samples[p] = append(samples[p], elapsed)
+			allocs[p] += mallocs
+			bytes[p] += total
+		}
+	}
+
+	stats := [3]*BenchStats{&b.Parse, &b.Part1, &b.Part2}
+	for p := range stats {
+		*stats[p] = summarise(samples[p])
+		stats[p].Allocs = allocs[p] / uint64(n)
+		stats[p].Bytes = bytes[p] / uint64(n)
+	}
+}
+
+func measure(fn func() error) (elapsed time.Duration, mallocs uint64, bytes uint64, err error) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	err = protect(fn)
+	elapsed = time.Since(start)
+	runtime.ReadMemStats(&after)
+	return elapsed, after.Mallocs - before.Mallocs, after.TotalAlloc - before.TotalAlloc, err
+}
+
+func summarise(samples []time.Duration) (stats BenchStats) {
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	stats.Min = samples[0]
+	stats.Median = percentile(samples, 0.5)
+	stats.P95 = percentile(samples, 0.95)
+	stats.Max = samples[len(samples)-1]
+	return
+}
+
+// Nearest-rank percentile of sorted samples
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// Baseline maps Bench.Key to a previously saved run
+type Baseline map[string]Bench
+
+func LoadBaseline(path string) (baseline Baseline, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var benches []Bench
+	if err = json.Unmarshal(data, &benches); err != nil {
+		return nil, fmt.Errorf("unable to read baseline %s: %w", path, err)
+	}
+	baseline = make(Baseline, len(benches))
+	for _, b := range benches {
+		baseline[b.Key()] = b
+	}
+	return
+}
+
+func SaveBaseline(path string, benches []Bench) error {
+	data, err := json.MarshalIndent(benches, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Change returns the relative change of each phase's median against the
+// baseline, e.g. 0.25 for 25% slower. ok is false when there is no baseline
+// for the dataset or either run failed.
+func (bl Baseline) Change(b Bench) (change [3]float64, ok bool) {
+	old, ok := bl[b.Key()]
+	if !ok || old.Failed != "" || b.Failed != "" {
+		return change, false
+	}
+	oldPhases, newPhases := old.Phases(), b.Phases()
+	for p := range change {
+		if oldPhases[p].Median == 0 {
+			continue
+		}
+		change[p] = float64(newPhases[p].Median-oldPhases[p].Median) / float64(oldPhases[p].Median)
+	}
+	return
+}
+
+// Regressions lists every phase whose median slowed down by more than
+// threshold, a fraction such as 0.1 for 10%
+func (bl Baseline) Regressions(benches []Bench, threshold float64) (regressions []string) {
+	for _, b := range benches {
+		change, ok := bl.Change(b)
+		if !ok {
+			continue
+		}
+		for p, c := range change {
+			if c > threshold {
+				regressions = append(regressions, fmt.Sprintf("%s %s: median %+.1f%%", b.Key(), phaseNames[p], c*100))
+			}
+		}
+	}
+	return
+}
+
+// PrintBenchTable writes one row per phase of each dataset. The change column
+// only appears when a baseline is given.
+func PrintBenchTable(w io.Writer, benches []Bench, baseline Baseline) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := "Year\tDay\tName\tDataset\tPhase\tMin\tMedian\tP95\tMax\tAllocs/op\tBytes/op"
+	if baseline != nil {
+		header += "\tChange"
+	}
+	if _, err := fmt.Fprintln(tw, header); err != nil {
+		return err
+	}
+
+	for _, b := range benches {
+		if b.Failed != "" {
+			if _, err := fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\tFAILED\n", b.Year, b.Problem, b.Name, b.Dataset, b.Failed); err != nil {
+				return err
+			}
+			continue
+		}
+		change, ok := baseline.Change(b)
+		for p, stats := range b.Phases() {
+			fmt.Fprintf(
+				tw,
+				"%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d",
+				b.Year,
+				b.Problem,
+				b.Name,
+				b.Dataset,
+				phaseNames[p],
+				round(stats.Min),
+				round(stats.Median),
+				round(stats.P95),
+				round(stats.Max),
+				stats.Allocs,
+				stats.Bytes,
+			)
+			if baseline != nil {
+				if ok {
+					fmt.Fprintf(tw, "\t%+.1f%%", change[p]*100)
+				} else {
+					fmt.Fprint(tw, "\t-")
+				}
+			}
+			if _, err := fmt.Fprintln(tw); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, b := range benches {
+		if b.Failed == "" {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "\n%d/%02d %s %s %s: %s\n", b.Year, b.Problem, b.Name, b.Dataset, b.Failed, b.Error); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		p      float64
+		expect time.Duration
+	}{
+		{0, 1},
+		{0.1, 1},
+		{0.11, 2},
+		{0.5, 5},
+		{0.95, 10},
+		{1, 10},
+	}
+	for _, test := range tests {
+		if got := percentile(sorted, test.p); got != test.expect {
+			t.Errorf("p%g: got %d, expected %d", test.p*100, got, test.expect)
+		}
+	}
+
+	if got := percentile([]time.Duration{7}, 0.95); got != 7 {
+		t.Errorf("single sample: got %d, expected 7", got)
+	}
+}
+
+func TestSummarise(t *testing.T) {
+	stats := summarise([]time.Duration{40, 10, 30, 20})
+	expect := BenchStats{Min: 10, Median: 20, P95: 40, Max: 40}
+	if stats != expect {
+		t.Errorf("got %+v, expected %+v", stats, expect)
+	}
+}
+
+// medians builds a bench for 2000/01 Input with the given phase medians
+func medians(parse, part1, part2 time.Duration) Bench {
+	return Bench{
+		Year:    2000,
+		Problem: 1,
+		Dataset: "Input",
+		Parse:   BenchStats{Median: parse},
+		Part1:   BenchStats{Median: part1},
+		Part2:   BenchStats{Median: part2},
+	}
+}
+
+func TestBaselineChange(t *testing.T) {
+	old := medians(100, 200, 0)
+	baseline := Baseline{old.Key(): old}
+
+	change, ok := baseline.Change(medians(125, 100, 50))
+	if !ok {
+		t.Fatal("expected a baseline for the dataset")
+	}
+	// A phase with no baseline median has no change rather than infinity
+	if expect := [3]float64{0.25, -0.5, 0}; change != expect {
+		t.Errorf("got %v, expected %v", change, expect)
+	}
+
+	missing := medians(100, 200, 300)
+	missing.Dataset = "Test"
+	if _, ok := baseline.Change(missing); ok {
+		t.Error("expected no change for a dataset missing from the baseline")
+	}
+
+	failed := medians(0, 0, 0)
+	failed.Failed = "part 1"
+	if _, ok := baseline.Change(failed); ok {
+		t.Error("expected no change for a failed run")
+	}
+
+	if _, ok := Baseline(nil).Change(old); ok {
+		t.Error("expected no change without a baseline")
+	}
+}
+
+func TestRegressions(t *testing.T) {
+	old := medians(100, 100, 100)
+	baseline := Baseline{old.Key(): old}
+
+	// Parse slows down by exactly the threshold, part 1 by more and part 2
+	// speeds up
+	benches := []Bench{medians(110, 125, 50)}
+	missing := medians(1000, 1000, 1000)
+	missing.Dataset = "Test"
+	benches = append(benches, missing)
+
+	got := baseline.Regressions(benches, 0.1)
+	expect := []string{"2000/01 Input part 1: median +25.0%"}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("got %q, expected %q", got, expect)
+	}
+}
+
+// failingWriter turns down every write
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestPrintBenchTableError(t *testing.T) {
+	failed := medians(0, 0, 0)
+	failed.Failed, failed.Error = "parse", "bad input"
+	for _, benches := range [][]Bench{nil, {medians(1, 2, 3)}, {failed}} {
+		if err := PrintBenchTable(failingWriter{}, benches, nil); err == nil {
+			t.Errorf("%d benches: expected the write error", len(benches))
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Genregistry writes registry_gen.go, which blank imports every puzzle
+// package so its init can register the solution. Run it through go generate
+// from the repository root.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jbaikge/advent-of-code/internal/registry"
+)
+
+func main() {
+	check := flag.Bool("check", false, "fail if the registry is out of date instead of writing it")
+	root := flag.String("root", ".", "repository root")
+	flag.Parse()
+
+	run := registry.Write
+	if *check {
+		run = registry.Check
+	}
+	if err := run(*root); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
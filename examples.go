@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jbaikge/advent-of-code/internal/aoc"
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+func examplesMain(args []string) {
+	flags := flag.NewFlagSet("examples", flag.ExitOnError)
+	pagePath := flags.String("page", "", "read the puzzle description from the HTML saved in `file` instead of fetching it")
+	configPath := flags.String("config", "", "read the session and base URL from `file` instead of the user config directory")
+	baseURL := flags.String("base-url", "", "fetch from `url` instead of the configured server")
+	answers := flags.String("answers", "answers.json", "record the example answers in `file`")
+	force := flags.Bool("force", false, "overwrite existing test files")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s examples [flags] YEAR DAY\n", filepath.Base(os.Args[0]))
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	year, day, err := parseDay(flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	var page []byte
+	if *pagePath != "" {
+		page, err = os.ReadFile(*pagePath)
+	} else {
+		page, err = fetchPuzzle(year, day, *configPath, *baseURL)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := writeExamples(year, day, aoc.ParsePuzzle(string(page)), *answers, *force); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func fetchPuzzle(year int, day int, configPath string, baseURL string) (page []byte, err error) {
+	config, err := loadConfig(configPath, baseURL)
+	if err != nil {
+		return
+	}
+	client, err := aoc.NewClient(config)
+	if err != nil {
+		return
+	}
+	return client.Puzzle(context.Background(), year, day)
+}
+
+// writeExamples saves the first example of part 1 as test.txt. Part 2 reuses
+// it unless its description brings an example of its own, which becomes
+// test2.txt. The example answers go into the manifest against those datasets.
+func writeExamples(year int, day int, parts []aoc.Part, answersPath string, force bool) (err error) {
+	if len(parts) == 0 || len(parts[0].Examples) == 0 {
+		return fmt.Errorf("no example found in the puzzle description")
+	}
+
+	dir, err := puzzleDir(year, day)
+	if err != nil {
+		return
+	}
+	manifest, err := LoadManifest(answersPath)
+	if err != nil {
+		return
+	}
+
+	example := parts[0].Examples[0]
+	if err = writeExample(filepath.Join(dir, "test.txt"), example, force); err != nil {
+		return
+	}
+	if parts[0].Answer != "" {
+		manifest.Set(year, day, "Test", 1, solutions.Parse(parts[0].Answer))
+	}
+
+	if len(parts) > 1 && parts[1].Answer != "" {
+		dataset := "Test"
+		if len(parts[1].Examples) > 0 && parts[1].Examples[0] != example {
+			dataset = "Test 2"
+			if err = writeExample(filepath.Join(dir, "test2.txt"), parts[1].Examples[0], force); err != nil {
+				return
+			}
+			fmt.Println("Add a \"Test 2\" dataset embedding test2.txt to Meta if the solution lists its own")
+		}
+		manifest.Set(year, day, dataset, 2, solutions.Parse(parts[1].Answer))
+	}
+
+	return manifest.Save(answersPath)
+}
+
+func writeExample(path string, example string, force bool) error {
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 && !force {
+		return fmt.Errorf("%s already has an example, use -force to replace it", path)
+	}
+	if err := os.WriteFile(path, []byte(example), 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %s\n", path)
+	return nil
+}
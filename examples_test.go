@@ -0,0 +1,99 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/internal/aoc"
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+const examplesPage = `<main>
+<article class="day-desc"><h2>--- Day 3: Stub ---</h2>
+<p>For example:</p>
+<pre><code>1 2
+3 4
+</code></pre>
+<p>The answer is <code><em>10</em></code>.</p>
+</article>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<p>Now with more numbers:</p>
+<pre><code>5 6
+7 <em>8</em>
+</code></pre>
+<p>The answer is <em><code>26</code></em>.</p>
+</article>
+</main>`
+
+// TestWriteExamples writes test.txt for part 1 and test2.txt for the example
+// part 2 brings of its own, records both answers against their datasets and
+// only replaces an existing example with force
+func TestWriteExamples(t *testing.T) {
+	_, dir := inTempDir(t)
+	puzzle := filepath.Join("2000", "03-stub")
+	if err := os.MkdirAll(puzzle, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	answers := filepath.Join(dir, "answers.json")
+	parts := aoc.ParsePuzzle(examplesPage)
+
+	if err := writeExamples(2000, 3, parts, answers, false); err != nil {
+		t.Fatal(err)
+	}
+	for name, expect := range map[string]string{"test.txt": "1 2\n3 4\n", "test2.txt": "5 6\n7 8\n"} {
+		if data, err := os.ReadFile(filepath.Join(puzzle, name)); err != nil || string(data) != expect {
+			t.Errorf("%s: got %q, %v, expected %q", name, data, err, expect)
+		}
+	}
+
+	manifest, err := LoadManifest(answers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := manifest[manifestKey(2000, 3)]
+	if got := expected["Test"][part1Key]; !got.Equal(solutions.Int(10)) {
+		t.Errorf("Test part 1: got %v, expected 10", got)
+	}
+	if got := expected["Test 2"][part2Key]; !got.Equal(solutions.Int(26)) {
+		t.Errorf("Test 2 part 2: got %v, expected 26", got)
+	}
+	if _, ok := expected["Test"][part2Key]; ok {
+		t.Error("Test part 2: expected no answer against the part 1 example")
+	}
+
+	if err := writeExamples(2000, 3, parts, answers, false); err == nil || !strings.Contains(err.Error(), "-force") {
+		t.Errorf("got %v, expected the existing example to be refused", err)
+	}
+	if err := writeExamples(2000, 3, parts, answers, true); err != nil {
+		t.Errorf("got %v, expected -force to replace the example", err)
+	}
+}
+
+// TestWriteExamplesShared records part 2 against test.txt when part 2 shows no
+// example of its own
+func TestWriteExamplesShared(t *testing.T) {
+	_, dir := inTempDir(t)
+	puzzle := filepath.Join("2000", "03-stub")
+	if err := os.MkdirAll(puzzle, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	answers := filepath.Join(dir, "answers.json")
+	parts := []aoc.Part{{Examples: []string{"1 2\n"}, Answer: "3"}, {Answer: "ABC"}}
+
+	if err := writeExamples(2000, 3, parts, answers, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(puzzle, "test2.txt")); err == nil {
+		t.Error("expected no test2.txt")
+	}
+	manifest, err := LoadManifest(answers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := manifest[manifestKey(2000, 3)]["Test"]
+	if !expected[part1Key].Equal(solutions.Int(3)) || !expected[part2Key].Equal(solutions.String("ABC")) {
+		t.Errorf("got %v, expected 3 and ABC", expected)
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/jbaikge/advent-of-code/internal/aoc"
+	"github.com/jbaikge/advent-of-code/internal/vault"
+)
+
+// parseDay reads the YEAR DAY arguments shared by the subcommands
+func parseDay(yearArg string, dayArg string) (year int, day int, err error) {
+	if year, err = strconv.Atoi(yearArg); err != nil {
+		return 0, 0, fmt.Errorf("invalid year: %s", yearArg)
+	}
+	if day, err = strconv.Atoi(dayArg); err != nil || day < 1 || day > 25 {
+		return 0, 0, fmt.Errorf("invalid day: %s", dayArg)
+	}
+	return
+}
+
+// puzzleDir finds the YYYY/DD-name directory of a puzzle
+func puzzleDir(year int, day int) (dir string, err error) {
+	matches, err := filepath.Glob(fmt.Sprintf("%04d/%02d-*", year, day))
+	switch {
+	case err != nil:
+	case len(matches) == 0:
+		err = fmt.Errorf("no directory for %d/%02d, create one with scaffold first", year, day)
+	case len(matches) > 1:
+		err = fmt.Errorf("more than one directory for %d/%02d: %v", year, day, matches)
+	default:
+		dir = matches[0]
+	}
+	return
+}
+
+// loadConfig applies the -config and -base-url flags shared by the
+// subcommands that talk to the server
+func loadConfig(configPath string, baseURL string) (config aoc.Config, err error) {
+	if config, err = aoc.LoadConfig(configPath); err != nil {
+		return
+	}
+	if baseURL != "" {
+		config.BaseURL = baseURL
+	}
+	return
+}
+
+func fetchMain(args []string) {
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+	configPath := flags.String("config", "", "read the session and base URL from `file` instead of the user config directory")
+	baseURL := flags.String("base-url", "", "fetch from `url` instead of the configured server")
+	cacheDir := flags.String("cache", "", "keep downloaded inputs in `dir` instead of the user cache directory")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s fetch [flags] YEAR DAY\n", filepath.Base(os.Args[0]))
+		fmt.Fprintf(flags.Output(), "The input is sealed with the key in %s before it is written.\n", vault.KeyEnv)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	year, day, err := parseDay(flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if err := fetch(year, day, *configPath, *baseURL, *cacheDir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// fetch fills in an empty input.txt, from the cache when possible. An input
+// that is already there is never fetched again. The input is sealed so that
+// it can be committed without being published; without a key nothing is
+// written.
+func fetch(year int, day int, configPath string, baseURL string, cacheDir string) (err error) {
+	dir, err := puzzleDir(year, day)
+	if err != nil {
+		return
+	}
+	key, err := vault.Key()
+	if err != nil {
+		return fmt.Errorf("%w: set %s, lock -genkey makes one", err, vault.KeyEnv)
+	}
+	target := filepath.Join(dir, "input.txt")
+	if info, err := os.Stat(target); err == nil && info.Size() > 0 {
+		return fmt.Errorf("input already fetched: %s", target)
+	}
+
+	cache := aoc.Cache{Dir: cacheDir}
+	if cacheDir == "" {
+		if cache, err = aoc.DefaultCache(); err != nil {
+			return
+		}
+	}
+
+	input, ok, err := cache.Input(year, day)
+	if err != nil {
+		return
+	}
+	if ok {
+		fmt.Printf("Restored %s from cache\n", target)
+		return writeSealed(target, key, input)
+	}
+
+	config, err := loadConfig(configPath, baseURL)
+	if err != nil {
+		return
+	}
+	client, err := aoc.NewClient(config)
+	if err != nil {
+		return
+	}
+	if input, err = client.Input(context.Background(), year, day); err != nil {
+		return
+	}
+	if err = cache.SaveInput(year, day, input); err != nil {
+		return
+	}
+	fmt.Printf("Fetched %s\n", target)
+	return writeSealed(target, key, input)
+}
+
+func writeSealed(path string, key []byte, data []byte) error {
+	sealed, err := vault.Seal(key, data)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, sealed, 0o644)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/internal/aoc"
+	"github.com/jbaikge/advent-of-code/internal/vault"
+)
+
+// TestFetch fetches and seals an input, refuses to fetch it again, then
+// restores it from the cache without asking the server
+func TestFetch(t *testing.T) {
+	var gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2000/day/5/input" {
+			http.NotFound(w, r)
+			return
+		}
+		gets++
+		w.Write([]byte("1000\n2000\n"))
+	}))
+	defer server.Close()
+
+	_, dir := inTempDir(t)
+	puzzle := filepath.Join("2000", "05-stub")
+	if err := os.MkdirAll(puzzle, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(puzzle, "input.txt")
+	if err := os.WriteFile(target, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := vault.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(vault.KeyEnv, key)
+	t.Setenv(aoc.SessionEnv, "secret")
+	config, cache := filepath.Join(dir, "config.json"), filepath.Join(dir, "cache")
+	fetchStub := func() error {
+		return fetch(2000, 5, config, server.URL+"/", cache)
+	}
+
+	if err := fetchStub(); err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !vault.Sealed(sealed) {
+		t.Fatalf("got %q, expected the input to be sealed", sealed)
+	}
+	if input, err := vault.Open(sealed); err != nil || string(input) != "1000\n2000\n" {
+		t.Errorf("got %q, %v, expected the fetched input", input, err)
+	}
+
+	if err := fetchStub(); err == nil || !strings.Contains(err.Error(), "already fetched") {
+		t.Errorf("got %v, expected a fetched input to be refused", err)
+	}
+
+	if err := os.WriteFile(target, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := fetchStub(); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input, err := vault.Open(restored); err != nil || string(input) != "1000\n2000\n" {
+		t.Errorf("got %q, %v, expected the cached input", input, err)
+	}
+
+	if gets != 1 {
+		t.Errorf("server got %d requests, expected 1", gets)
+	}
+}
+
+func TestFetchNeedsKey(t *testing.T) {
+	inTempDir(t)
+	if err := os.MkdirAll(filepath.Join("2000", "05-stub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(vault.KeyEnv, "")
+	if err := fetch(2000, 5, "", "http://127.0.0.1:0/", t.TempDir()); err == nil || !strings.Contains(err.Error(), vault.KeyEnv) {
+		t.Errorf("got %v, expected to be asked for %s", err, vault.KeyEnv)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/solutions/solutionstest"
+)
+
+// TestGolden checks every registered solution against answers.json and the
+// expectations in code. Use -short to run only the examples.
+func TestGolden(t *testing.T) {
+	manifest, err := LoadManifest("answers.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, solution := range solutions.All() {
+		meta := solution.Meta()
+		name := fmt.Sprintf("%d/%02d %s", meta.Year, meta.Problem, meta.Name)
+		t.Run(name, func(t *testing.T) {
+			solutionstest.Check(t, solution, manifest.Datas(meta))
+		})
+	}
+}
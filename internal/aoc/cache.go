@@ -0,0 +1,110 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Cache keeps downloaded inputs so a deleted input.txt can be restored
+// without asking the server again. It also remembers wrong guesses and rate
+// limits, since those belong to the user rather than the repository.
+type Cache struct {
+	Dir string
+}
+
+// DefaultCache lives in the user's cache directory
+func DefaultCache() (cache Cache, err error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return
+	}
+	cache.Dir = filepath.Join(dir, "advent-of-code")
+	return
+}
+
+func (c Cache) inputPath(year int, day int) string {
+	return filepath.Join(c.Dir, "inputs", fmt.Sprintf("%04d-%02d.txt", year, day))
+}
+
+// Input returns the cached input, or ok false when there is none
+func (c Cache) Input(year int, day int) (input []byte, ok bool, err error) {
+	input, err = os.ReadFile(c.inputPath(year, day))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	return input, err == nil, err
+}
+
+func (c Cache) SaveInput(year int, day int, input []byte) error {
+	path := c.inputPath(year, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, input, 0o600)
+}
+
+// Guess is a submitted answer the server turned down
+type Guess struct {
+	Part    int     `json:"part"`
+	Answer  string  `json:"answer"`
+	Verdict Verdict `json:"verdict"`
+}
+
+func (c Cache) guessPath(year int, day int) string {
+	return filepath.Join(c.Dir, "guesses", fmt.Sprintf("%04d-%02d.json", year, day))
+}
+
+// Guesses lists the wrong answers already submitted for the day
+func (c Cache) Guesses(year int, day int) (guesses []Guess, err error) {
+	data, err := os.ReadFile(c.guessPath(year, day))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &guesses)
+	return
+}
+
+func (c Cache) AddGuess(year int, day int, guess Guess) error {
+	guesses, err := c.Guesses(year, day)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(append(guesses, guess), "", "  ")
+	if err != nil {
+		return err
+	}
+	path := c.guessPath(year, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// WaitUntil is when the server will accept the next submission. The zero
+// time means no wait is known.
+func (c Cache) WaitUntil() (until time.Time, err error) {
+	data, err := os.ReadFile(filepath.Join(c.Dir, "wait-until"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return until, nil
+	}
+	if err != nil {
+		return
+	}
+	return time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+}
+
+func (c Cache) SetWaitUntil(until time.Time) error {
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(c.Dir, "wait-until"), []byte(until.Format(time.RFC3339)+"\n"), 0o600)
+}
@@ -0,0 +1,79 @@
+// Package aoc talks to Advent of Code, or any server that answers the same
+// URLs, on behalf of a logged in user
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const userAgent = "github.com/jbaikge/advent-of-code"
+
+var (
+	ErrNotAvailable = errors.New("puzzle not available")
+	ErrUnauthorized = errors.New("session cookie rejected")
+)
+
+type Client struct {
+	BaseURL string
+	Session string
+	HTTP    *http.Client
+}
+
+func NewClient(config Config) (*Client, error) {
+	if config.Session == "" {
+		return nil, fmt.Errorf("no session cookie, set %s or add it to the config file", SessionEnv)
+	}
+	return &Client{
+		BaseURL: strings.TrimRight(config.BaseURL, "/"),
+		Session: config.Session,
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Input downloads the puzzle input for the day
+func (c *Client) Input(ctx context.Context, year int, day int) (input []byte, err error) {
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader) (resp *http.Response, err error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	if resp, err = c.HTTP.Do(req); err != nil {
+		return
+	}
+	if resp.StatusCode == http.StatusOK {
+		return
+	}
+
+	// Advent of Code answers a bad session with a 400 or 500 asking to log in
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		err = fmt.Errorf("%s %s: %w", method, path, ErrNotAvailable)
+	case resp.StatusCode == http.StatusUnauthorized, strings.Contains(string(msg), "log in"):
+		err = fmt.Errorf("%s %s: %w", method, path, ErrUnauthorized)
+	default:
+		err = fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil, err
+}
@@ -0,0 +1,57 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := NewClient(Config{Session: "secret", BaseURL: server.URL + "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestInput(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2022/day/1/input" {
+			http.NotFound(w, r)
+			return
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("1000\n2000\n"))
+	})
+
+	input, err := client.Input(context.Background(), 2022, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(input) != "1000\n2000\n" {
+		t.Errorf("got %q", input)
+	}
+
+	if _, err = client.Input(context.Background(), 2022, 2); !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected ErrNotAvailable, got %v", err)
+	}
+
+	client.Session = "wrong"
+	if _, err = client.Input(context.Background(), 2022, 1); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestNewClientNeedsSession(t *testing.T) {
+	if _, err := NewClient(Config{BaseURL: DefaultBaseURL}); err == nil {
+		t.Error("expected an error without a session")
+	}
+}
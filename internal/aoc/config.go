@@ -0,0 +1,66 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+
+	SessionEnv = "AOC_SESSION"
+	BaseURLEnv = "AOC_BASE_URL"
+)
+
+// Config is read from config.json in the user's config directory. The
+// environment overrides the file so CI can supply the session as a secret.
+type Config struct {
+	Session string `json:"session"`
+	BaseURL string `json:"base_url"`
+}
+
+// ConfigPath is where LoadConfig looks when it is given no path
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "advent-of-code", "config.json"), nil
+}
+
+// LoadConfig reads path, or ConfigPath when path is empty. A missing file
+// is fine as long as the environment fills in the gaps.
+func LoadConfig(path string) (config Config, err error) {
+	if path == "" {
+		if path, err = ConfigPath(); err != nil {
+			return
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		err = nil
+	case err != nil:
+		return
+	default:
+		if err = json.Unmarshal(data, &config); err != nil {
+			return config, fmt.Errorf("unable to read config %s: %w", path, err)
+		}
+	}
+
+	if session := os.Getenv(SessionEnv); session != "" {
+		config.Session = session
+	}
+	if baseURL := os.Getenv(BaseURLEnv); baseURL != "" {
+		config.BaseURL = baseURL
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Part is what the description of one puzzle part gives away: the example
+// inputs shown in <pre><code> blocks and the example answer, which by
+// convention is the last emphasized code in the text
+type Part struct {
+	Examples []string
+	Answer   string
+}
+
+var (
+	descRe    = regexp.MustCompile(`(?s)<article class="day-desc">(.*?)</article>`)
+	exampleRe = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	answerRe  = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>|<em><code>(.*?)</code></em>`)
+)
+
+// Puzzle downloads the description page for the day. Part 2 only appears
+// once part 1 is solved.
+func (c *Client) Puzzle(ctx context.Context, year int, day int) (page []byte, err error) {
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d", year, day), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// ParsePuzzle returns one Part per description on the page
+func ParsePuzzle(page string) (parts []Part) {
+	for _, desc := range descRe.FindAllStringSubmatch(page, -1) {
+		var part Part
+		for _, m := range exampleRe.FindAllStringSubmatch(desc[1], -1) {
+			part.Examples = append(part.Examples, text(m[1]))
+		}
+		if ms := answerRe.FindAllStringSubmatch(desc[1], -1); len(ms) > 0 {
+			last := ms[len(ms)-1]
+			part.Answer = strings.TrimSpace(text(last[1] + last[2]))
+		}
+		parts = append(parts, part)
+	}
+	return
+}
+
+// text drops markup such as the <em> used to highlight parts of an example
+func text(s string) string {
+	return html.UnescapeString(tagRe.ReplaceAllString(s, ""))
+}
@@ -0,0 +1,41 @@
+package aoc
+
+import "testing"
+
+const puzzlePage = `<html><body><main>
+<article class="day-desc"><h2>--- Day 1: Calorie Counting ---</h2>
+<p>For example:</p>
+<pre><code>1000
+2000
+
+&lt;3000&gt;
+</code></pre>
+<p>This Elf is carrying <code><em>3000</em></code> Calories, the most of <em>any</em> Elf.</p>
+<p>In total, <em><code>6000</code></em> Calories.</p>
+</article>
+<p>Your puzzle answer was <code>70698</code>.</p>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<p>The sum is <code><em>45000</em></code>.</p>
+</article>
+</main></body></html>`
+
+func TestParsePuzzle(t *testing.T) {
+	parts := ParsePuzzle(puzzlePage)
+	if len(parts) != 2 {
+		t.Fatalf("expected 2 parts, got %d", len(parts))
+	}
+
+	if len(parts[0].Examples) != 1 || parts[0].Examples[0] != "1000\n2000\n\n<3000>\n" {
+		t.Errorf("part 1 examples: %q", parts[0].Examples)
+	}
+	if parts[0].Answer != "6000" {
+		t.Errorf("part 1 answer: %q", parts[0].Answer)
+	}
+
+	if len(parts[1].Examples) != 0 {
+		t.Errorf("part 2 examples: %q", parts[1].Examples)
+	}
+	if parts[1].Answer != "45000" {
+		t.Errorf("part 2 answer: %q", parts[1].Answer)
+	}
+}
@@ -0,0 +1,99 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Verdict string
+
+const (
+	Correct    Verdict = "correct"
+	TooHigh    Verdict = "too high"
+	TooLow     Verdict = "too low"
+	Wrong      Verdict = "wrong"
+	TooSoon    Verdict = "too soon"
+	WrongLevel Verdict = "wrong level"
+	Unknown    Verdict = "unknown"
+)
+
+// Outcome is what the server made of a submitted answer. Wait is how long to
+// hold off before the next submission, when the page says.
+type Outcome struct {
+	Verdict Verdict
+	Wait    time.Duration
+	Message string
+}
+
+var (
+	articleRe  = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRe      = regexp.MustCompile(`<[^>]+>`)
+	leftRe     = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	minutesRe  = regexp.MustCompile(`wait (one|\d+) minutes?`)
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+// Submit posts the answer for one part of a day
+func (c *Client) Submit(ctx context.Context, year int, day int, part int, answer string) (outcome Outcome, err error) {
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+	resp, err := c.do(ctx, "POST", fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	page, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	return ParseOutcome(string(page)), nil
+}
+
+// ParseOutcome reads the verdict out of the page returned after submitting
+func ParseOutcome(page string) (outcome Outcome) {
+	text := page
+	if m := articleRe.FindStringSubmatch(page); m != nil {
+		text = m[1]
+	}
+	text = html.UnescapeString(tagRe.ReplaceAllString(text, ""))
+	outcome.Message = strings.TrimSpace(whitespace.ReplaceAllString(text, " "))
+
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		outcome.Verdict = Correct
+	case strings.Contains(text, "You gave an answer too recently"):
+		outcome.Verdict = TooSoon
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		outcome.Verdict = WrongLevel
+	case strings.Contains(text, "your answer is too high"):
+		outcome.Verdict = TooHigh
+	case strings.Contains(text, "your answer is too low"):
+		outcome.Verdict = TooLow
+	case strings.Contains(text, "That's not the right answer"):
+		outcome.Verdict = Wrong
+	default:
+		outcome.Verdict = Unknown
+	}
+
+	if m := leftRe.FindStringSubmatch(text); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		outcome.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := minutesRe.FindStringSubmatch(text); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		outcome.Wait = time.Duration(minutes) * time.Minute
+	}
+	return
+}
@@ -0,0 +1,89 @@
+package aoc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseOutcome(t *testing.T) {
+	tests := []struct {
+		Page    string
+		Verdict Verdict
+		Wait    time.Duration
+	}{
+		{
+			Page:    `<main><article><p>That's the right answer!  You are <em>one gold star</em> closer to saving your vacation.</p></article></main>`,
+			Verdict: Correct,
+		},
+		{
+			Page:    `<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article>`,
+			Verdict: TooHigh,
+			Wait:    time.Minute,
+		},
+		{
+			Page:    `<article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.</p></article>`,
+			Verdict: TooLow,
+			Wait:    5 * time.Minute,
+		},
+		{
+			Page:    `<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.</p></article>`,
+			Verdict: Wrong,
+		},
+		{
+			Page:    `<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 32s left to wait.</p></article>`,
+			Verdict: TooSoon,
+			Wait:    4*time.Minute + 32*time.Second,
+		},
+		{
+			Page:    `<article><p>You gave an answer too recently.  You have 45s left to wait.</p></article>`,
+			Verdict: TooSoon,
+			Wait:    45 * time.Second,
+		},
+		{
+			Page:    `<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`,
+			Verdict: WrongLevel,
+		},
+		{
+			Page:    `<html>something else</html>`,
+			Verdict: Unknown,
+		},
+	}
+
+	for _, test := range tests {
+		outcome := ParseOutcome(test.Page)
+		if outcome.Verdict != test.Verdict || outcome.Wait != test.Wait {
+			t.Errorf("%q: got %s, wait %s; expected %s, wait %s", outcome.Message, outcome.Verdict, outcome.Wait, test.Verdict, test.Wait)
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2022/day/1/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.FormValue("level") == "1" && r.FormValue("answer") == "24000" {
+			w.Write([]byte(`<article><p>That's the right answer!</p></article>`))
+			return
+		}
+		w.Write([]byte(`<article><p>That's not the right answer; your answer is too low.  Please wait one minute before trying again.</p></article>`))
+	})
+
+	outcome, err := client.Submit(context.Background(), 2022, 1, 1, "24000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outcome.Verdict != Correct {
+		t.Errorf("expected correct, got %s", outcome.Verdict)
+	}
+
+	if outcome, err = client.Submit(context.Background(), 2022, 1, 1, "100"); err != nil {
+		t.Fatal(err)
+	}
+	if outcome.Verdict != TooLow || outcome.Wait != time.Minute {
+		t.Errorf("expected too low with a minute to wait, got %s, %s", outcome.Verdict, outcome.Wait)
+	}
+}
@@ -0,0 +1,142 @@
+// Package registry finds the puzzle packages under the repository root and
+// writes the file that imports them all into the runner
+package registry
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	ModulePath = "github.com/jbaikge/advent-of-code"
+	Filename   = "registry_gen.go"
+)
+
+var puzzleDir = regexp.MustCompile(`^\d{4}/\d{2}-[a-z0-9-]+$`)
+
+// Scan returns the YYYY/DD-name directories below root, sorted. Every one of
+// them must contain a package that calls solutions.Register; a directory that
+// does not would silently never run, so it is an error.
+func Scan(root string) (dirs []string, err error) {
+	matches, err := filepath.Glob(filepath.Join(root, "[0-9][0-9][0-9][0-9]", "[0-9][0-9]-*"))
+	if err != nil {
+		return
+	}
+
+	var missing []string
+	for _, match := range matches {
+		rel, err := filepath.Rel(root, match)
+		if err != nil {
+			return nil, err
+		}
+		rel = filepath.ToSlash(rel)
+		if info, err := os.Stat(match); err != nil || !info.IsDir() || !puzzleDir.MatchString(rel) {
+			continue
+		}
+
+		ok, err := registers(match)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			missing = append(missing, rel)
+			continue
+		}
+		dirs = append(dirs, rel)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no solutions.Register call in: %s", strings.Join(missing, ", "))
+	}
+	sort.Strings(dirs)
+	return
+}
+
+// registers reports whether any non-test Go file in dir calls
+// solutions.Register
+func registers(dir string) (found bool, err error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return !found
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return !found
+				}
+				if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "solutions" && sel.Sel.Name == "Register" {
+					found = true
+				}
+				return !found
+			})
+		}
+	}
+	return
+}
+
+// Generate renders the registry file that blank imports every directory
+func Generate(dirs []string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by cmd/genregistry; DO NOT EDIT.\n\n")
+	buf.WriteString("package main\n\n")
+	buf.WriteString("import (\n")
+	for _, dir := range dirs {
+		fmt.Fprintf(&buf, "\t_ %q\n", ModulePath+"/"+dir)
+	}
+	buf.WriteString(")\n")
+	return format.Source(buf.Bytes())
+}
+
+// Write scans root and writes the registry file into it
+func Write(root string) error {
+	src, err := build(root)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(root, Filename), src, 0o644)
+}
+
+// Check fails when the registry file in root does not match what Write would
+// produce
+func Check(root string) error {
+	src, err := build(root)
+	if err != nil {
+		return err
+	}
+	current, err := os.ReadFile(filepath.Join(root, Filename))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(current, src) {
+		return fmt.Errorf("%s is out of date, run go generate", Filename)
+	}
+	return nil
+}
+
+func build(root string) ([]byte, error) {
+	dirs, err := Scan(root)
+	if err != nil {
+		return nil, err
+	}
+	return Generate(dirs)
+}
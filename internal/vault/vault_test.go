@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSealRoundTrip(t *testing.T) {
+	hexKey, err := NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(KeyEnv, hexKey)
+	key, err := Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := []byte("1000\n2000\n")
+	sealed, err := Seal(key, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Sealed(sealed) || bytes.Contains(sealed, input) {
+		t.Fatalf("input not sealed: %q", sealed)
+	}
+
+	opened, err := Open(sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(opened, input) {
+		t.Errorf("got %q, expected %q", opened, input)
+	}
+
+	sealed[len(sealed)-1] ^= 1
+	if _, err = Open(sealed); err == nil {
+		t.Error("expected tampered input to fail")
+	}
+}
+
+func TestOpenPlain(t *testing.T) {
+	t.Setenv(KeyEnv, "")
+	input := []byte("plain")
+	opened, err := Open(input)
+	if err != nil || !bytes.Equal(opened, input) {
+		t.Errorf("got %q, %v", opened, err)
+	}
+}
+
+func TestOpenLocked(t *testing.T) {
+	hexKey, _ := NewKey()
+	t.Setenv(KeyEnv, hexKey)
+	key, _ := Key()
+	sealed, err := Seal(key, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(KeyEnv, "")
+	if _, err = Open(sealed); !errors.Is(err, ErrLocked) {
+		t.Errorf("expected ErrLocked, got %v", err)
+	}
+}
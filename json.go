@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+// Record is the machine-readable form of a Result. Answers and expectations
+// are strings, or null when unknown; timings are in nanoseconds.
+type Record struct {
+	Year       int              `json:"year"`
+	Problem    int              `json:"problem"`
+	Name       string           `json:"name"`
+	Dataset    string           `json:"dataset"`
+	Answer1    solutions.Answer `json:"answer1"`
+	Answer2    solutions.Answer `json:"answer2"`
+	Expect1    solutions.Answer `json:"expect1"`
+	Expect2    solutions.Answer `json:"expect2"`
+	Status1    string           `json:"status1"`
+	Status2    string           `json:"status2"`
+	ParseError string           `json:"parse_error,omitempty"`
+	Part1Error string           `json:"part1_error,omitempty"`
+	Part2Error string           `json:"part2_error,omitempty"`
+	ParseStack string           `json:"parse_stack,omitempty"`
+	Part1Stack string           `json:"part1_stack,omitempty"`
+	Part2Stack string           `json:"part2_stack,omitempty"`
+	ParseNs    int64            `json:"parse_ns"`
+	Part1Ns    int64            `json:"part1_ns"`
+	Part2Ns    int64            `json:"part2_ns"`
+}
+
+func NewRecord(r Result) Record {
+	return Record{
+		Year:       r.Year,
+		Problem:    r.Problem,
+		Name:       r.Name,
+		Dataset:    r.Dataset,
+		Answer1:    r.Answer1,
+		Answer2:    r.Answer2,
+		Expect1:    r.Expect1,
+		Expect2:    r.Expect2,
+		Status1:    r.Status1(),
+		Status2:    r.Status2(),
+		ParseError: errorString(r.ParseErr),
+		Part1Error: errorString(r.Part1Err),
+		Part2Error: errorString(r.Part2Err),
+		ParseStack: stackOf(r.ParseErr),
+		Part1Stack: stackOf(r.Part1Err),
+		Part2Stack: stackOf(r.Part2Err),
+		ParseNs:    r.Parse.Nanoseconds(),
+		Part1Ns:    r.Part1.Nanoseconds(),
+		Part2Ns:    r.Part2.Nanoseconds(),
+	}
+}
+
+// PrintJSON writes all records as a single indented array
+func PrintJSON(w io.Writer, results []Result) error {
+	records := make([]Record, len(results))
+	for i, r := range results {
+		records[i] = NewRecord(r)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// PrintNDJSON writes one record per line
+func PrintNDJSON(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	for _, r := range results {
+		if err := enc.Encode(NewRecord(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// stackOf returns the stack trace captured with a recovered panic, if any
+func stackOf(err error) string {
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		return string(panicErr.Stack)
+	}
+	return ""
+}
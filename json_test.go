@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+// jsonResults has a pass, a fail, an unknown answer and an error
+var jsonResults = []Result{
+	{
+		Year:    2000,
+		Problem: 1,
+		Name:    "stub",
+		Dataset: "Test",
+		Answer1: solutions.Int(7),
+		Answer2: solutions.String("ABC"),
+		Expect1: solutions.Int(7),
+		Expect2: solutions.String("ABD"),
+		Parse:   time.Microsecond,
+		Part1:   2 * time.Microsecond,
+		Part2:   3 * time.Microsecond,
+	},
+	{
+		Year:     2000,
+		Problem:  1,
+		Name:     "stub",
+		Dataset:  "Input",
+		Answer1:  solutions.Int(0),
+		Part2Err: errors.New("no route"),
+	},
+}
+
+func TestPrintJSON(t *testing.T) {
+	expect := `[
+  {
+    "year": 2000,
+    "problem": 1,
+    "name": "stub",
+    "dataset": "Test",
+    "answer1": "7",
+    "answer2": "ABC",
+    "expect1": "7",
+    "expect2": "ABD",
+    "status1": "pass",
+    "status2": "fail",
+    "parse_ns": 1000,
+    "part1_ns": 2000,
+    "part2_ns": 3000
+  },
+  {
+    "year": 2000,
+    "problem": 1,
+    "name": "stub",
+    "dataset": "Input",
+    "answer1": "0",
+    "answer2": null,
+    "expect1": null,
+    "expect2": null,
+    "status1": "unknown",
+    "status2": "error",
+    "part2_error": "no route",
+    "parse_ns": 0,
+    "part1_ns": 0,
+    "part2_ns": 0
+  }
+]
+`
+	var buf bytes.Buffer
+	if err := PrintJSON(&buf, jsonResults); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != expect {
+		t.Errorf("got:\n%s\nexpected:\n%s", buf.String(), expect)
+	}
+}
+
+func TestPrintNDJSON(t *testing.T) {
+	expect := `{"year":2000,"problem":1,"name":"stub","dataset":"Test","answer1":"7","answer2":"ABC","expect1":"7","expect2":"ABD","status1":"pass","status2":"fail","parse_ns":1000,"part1_ns":2000,"part2_ns":3000}
+{"year":2000,"problem":1,"name":"stub","dataset":"Input","answer1":"0","answer2":null,"expect1":null,"expect2":null,"status1":"unknown","status2":"error","part2_error":"no route","parse_ns":0,"part1_ns":0,"part2_ns":0}
+`
+	var buf bytes.Buffer
+	if err := PrintNDJSON(&buf, jsonResults); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != expect {
+		t.Errorf("got:\n%s\nexpected:\n%s", buf.String(), expect)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/jbaikge/advent-of-code/internal/vault"
+)
+
+// lockMain seals (or with unlock, opens) puzzle inputs in place
+func lockMain(name string, args []string) {
+	if err := lockCommand(name, args, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// lockCommand runs lock or unlock. With no paths it covers every
+// YYYY/DD-name/input*.txt and passes over those already in the wanted state;
+// a path named outright must not be.
+func lockCommand(name string, args []string, out io.Writer) (err error) {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	genKey := flags.Bool("genkey", false, "print a new random key and exit")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s %s [flags] [PATH...]\n", filepath.Base(os.Args[0]), name)
+		fmt.Fprintf(flags.Output(), "The key is read from %s.\n", vault.KeyEnv)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if *genKey {
+		key, err := vault.NewKey()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, key)
+		return err
+	}
+
+	key, err := vault.Key()
+	if err != nil {
+		return fmt.Errorf("%w: set %s, %s -genkey makes one", err, vault.KeyEnv, name)
+	}
+
+	paths, strict := flags.Args(), true
+	if len(paths) == 0 {
+		if paths, err = filepath.Glob("[0-9][0-9][0-9][0-9]/[0-9][0-9]-*/input*.txt"); err != nil {
+			return
+		}
+		strict = false
+	}
+
+	for _, path := range paths {
+		if err := lockFile(path, key, name == "lock", strict); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return
+}
+
+// lockFile seals or opens path, leaving empty placeholders alone. A file
+// already in that state is an error when strict and left alone otherwise.
+func lockFile(path string, key []byte, seal bool, strict bool) (err error) {
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) == 0 {
+		return
+	}
+	if vault.Sealed(data) == seal {
+		if !strict {
+			return
+		}
+		if seal {
+			return fmt.Errorf("already sealed")
+		}
+		return fmt.Errorf("not sealed")
+	}
+	if seal {
+		data, err = vault.Seal(key, data)
+	} else {
+		data, err = vault.Unseal(key, data)
+	}
+	if err != nil {
+		return
+	}
+	return os.WriteFile(path, data, 0o644)
+}
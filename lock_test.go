@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/internal/vault"
+)
+
+// TestLock seals and opens an input with a key from -genkey, and refuses to
+// seal it twice or to work without a key
+func TestLock(t *testing.T) {
+	var out bytes.Buffer
+	if err := lockCommand("lock", []string{"-genkey"}, &out); err != nil {
+		t.Fatal(err)
+	}
+	key := strings.TrimSpace(out.String())
+	t.Setenv(vault.KeyEnv, key)
+
+	path := filepath.Join(t.TempDir(), "input.txt")
+	input := []byte("1000\n2000\n")
+	if err := os.WriteFile(path, input, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := lockCommand("lock", []string{path}, &out); err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !vault.Sealed(sealed) || bytes.Contains(sealed, input) {
+		t.Fatalf("got %q, expected the input to be sealed", sealed)
+	}
+
+	if err := lockCommand("lock", []string{path}, &out); err == nil || !strings.Contains(err.Error(), "already sealed") {
+		t.Errorf("got %v, expected a second lock to be refused", err)
+	}
+	if again, _ := os.ReadFile(path); !bytes.Equal(again, sealed) {
+		t.Error("expected a refused lock to leave the file alone")
+	}
+
+	t.Setenv(vault.KeyEnv, "")
+	for _, name := range []string{"lock", "unlock"} {
+		if err := lockCommand(name, []string{path}, &out); !errors.Is(err, vault.ErrLocked) {
+			t.Errorf("%s: got %v, expected to be asked for %s", name, err, vault.KeyEnv)
+		}
+	}
+	t.Setenv(vault.KeyEnv, key)
+
+	if err := lockCommand("unlock", []string{path}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if opened, err := os.ReadFile(path); err != nil || !bytes.Equal(opened, input) {
+		t.Errorf("got %q, %v, expected the original input", opened, err)
+	}
+	if err := lockCommand("unlock", []string{path}, &out); err == nil {
+		t.Error("expected unlocking a plain input to be refused")
+	}
+}
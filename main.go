@@ -1,175 +1,333 @@
+//go:generate go run ./cmd/genregistry
+
 package main
 
 import (
-	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
-	"log"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
-	chiton "github.com/jbaikge/advent-of-code/2021/15-chiton"
-	hillclimb "github.com/jbaikge/advent-of-code/2022/12-hill-climb"
-	distress "github.com/jbaikge/advent-of-code/2022/13-distress"
-	reservoir "github.com/jbaikge/advent-of-code/2022/14-reservoir"
-	sensors "github.com/jbaikge/advent-of-code/2022/15-sensors"
-	valves "github.com/jbaikge/advent-of-code/2022/16-valves"
-	tetris "github.com/jbaikge/advent-of-code/2022/17-tetris"
-	trebuchet "github.com/jbaikge/advent-of-code/2023/01-trebuchet"
-	cubeconundrum "github.com/jbaikge/advent-of-code/2023/02-cube-conundrum"
-	gearratios "github.com/jbaikge/advent-of-code/2023/03-gear-ratios"
-	scratchcards "github.com/jbaikge/advent-of-code/2023/04-scratchcards"
-	fertilizer "github.com/jbaikge/advent-of-code/2023/05-fertilizer"
-	waitforit "github.com/jbaikge/advent-of-code/2023/06-wait-for-it"
 	"github.com/jbaikge/advent-of-code/solutions"
-	"github.com/jbaikge/advent-of-code/util"
-
-	_ "github.com/jbaikge/advent-of-code/2023/07-camel-cards"
-	_ "github.com/jbaikge/advent-of-code/2023/08-haunted-wasteland"
-	_ "github.com/jbaikge/advent-of-code/2023/09-mirage-maintenance"
 )
 
-var utilSolutions = map[int]util.Solution{
-	202115: new(chiton.Solution),
-	202212: new(hillclimb.Solution),
-	202213: new(distress.Solution),
-	202214: new(reservoir.Solution),
-	202215: new(sensors.Solution),
-	202216: new(valves.Solution),
-	202217: new(tetris.Solution),
-	202301: new(trebuchet.Solution),
-	202302: new(cubeconundrum.Solution),
-	202303: new(gearratios.Solution),
-	202304: new(scratchcards.Solution),
-	202305: new(fertilizer.Solution),
-	202306: new(waitforit.Solution),
+const usage = `Usage:
+  %[1]s all                 run every registered puzzle
+  %[1]s YEAR                run every puzzle from YEAR
+  %[1]s YEAR DAY            run a single puzzle
+  %[1]s YEAR FIRST-LAST     run a range of days from YEAR
+  %[1]s scaffold YEAR DAY NAME
+                            create a new puzzle, see scaffold -h
+  %[1]s fetch YEAR DAY       download and seal the puzzle input, see fetch -h
+  %[1]s submit YEAR DAY PART submit an answer, see submit -h
+  %[1]s examples YEAR DAY    save the examples from the puzzle page, see examples -h
+  %[1]s lock|unlock [PATH...] encrypt or decrypt committed inputs, see lock -h
+`
+
+// Selection limits which registered solutions run; zero values match anything
+type Selection struct {
+	Year  int
+	First int
+	Last  int
 }
 
-func main() {
-	flag.Parse()
+func ParseSelection(args []string) (sel Selection, err error) {
+	if len(args) == 0 || len(args) > 2 {
+		err = fmt.Errorf("expected 1 or 2 arguments, got %d", len(args))
+		return
+	}
 
-	if len(flag.Args()) < 2 {
-		fmt.Println("Must provide a year and a problem number")
-		os.Exit(1)
+	if args[0] == "all" {
+		if len(args) > 1 {
+			err = fmt.Errorf("unexpected argument after all: %s", args[1])
+		}
+		return
 	}
 
-	year, err := strconv.Atoi(flag.Arg(0))
-	if err != nil {
-		fmt.Printf("Invalid year: %s\n", flag.Arg(0))
-		os.Exit(1)
+	if sel.Year, err = strconv.Atoi(args[0]); err != nil {
+		err = fmt.Errorf("invalid year: %s", args[0])
+		return
 	}
 
-	problem, err := strconv.Atoi(flag.Arg(1))
-	if err != nil {
-		fmt.Printf("Invalid problem: %s\n", flag.Arg(1))
-		os.Exit(1)
+	if len(args) == 1 {
+		return
 	}
 
-	solution, err := solutions.Get(year, problem)
-	if err != nil {
-		fmt.Printf("Could not find problem!\n")
-		os.Exit(1)
+	first, last, isRange := strings.Cut(args[1], "-")
+	if sel.First, err = strconv.Atoi(first); err != nil {
+		err = fmt.Errorf("invalid problem: %s", args[1])
+		return
+	}
+	sel.Last = sel.First
+	if isRange {
+		if sel.Last, err = strconv.Atoi(last); err != nil || sel.Last < sel.First {
+			err = fmt.Errorf("invalid problem range: %s", args[1])
+			return
+		}
 	}
+	return
+}
 
-	meta := solution.Meta()
-	fmt.Printf("%s\n", meta.Name)
+func (sel Selection) Match(meta solutions.Meta) bool {
+	if sel.Year != 0 && meta.Year != sel.Year {
+		return false
+	}
+	if sel.First != 0 && (meta.Problem < sel.First || meta.Problem > sel.Last) {
+		return false
+	}
+	return true
+}
 
-	for _, data := range meta.Datas {
-		fmt.Printf("\nProcessing data: %s\n", data.Name)
+// Inputs collects repeated -input flags
+type Inputs []string
 
-		parseStart := time.Now()
-		if err := solution.Parse(data.Input); err != nil {
-			log.Fatalf("Unable to parse data: %v", err)
-		}
-		parseTook := time.Since(parseStart)
+func (i *Inputs) String() string {
+	return strings.Join(*i, ",")
+}
 
-		part1Start := time.Now()
-		if answer, err := solution.Part1(); err != nil {
-			log.Fatalf("Part 1 failed: %v", err)
-		} else {
-			fmt.Printf("  Part 1: %d", answer)
-			if data.Expect1 != 0 {
-				if answer == data.Expect1 {
-					fmt.Print(" (Pass)")
-				} else {
-					fmt.Print(" (Fail)")
-				}
-			}
-			fmt.Println()
-		}
-		part1Took := time.Since(part1Start)
+func (i *Inputs) Set(path string) error {
+	*i = append(*i, path)
+	return nil
+}
 
-		part2Start := time.Now()
-		if answer, err := solution.Part2(); err != nil {
-			log.Fatalf("Part 2 failed: %v", err)
+// Datas reads each input into a dataset named after its path, or stdin for -
+func (i Inputs) Datas(expect1 string, expect2 string) (datas []solutions.Data, err error) {
+	datas = make([]solutions.Data, 0, len(i))
+	for _, path := range i {
+		data := solutions.Data{Name: path, File: path}
+		if path == "-" {
+			data.Name, data.File = "stdin", "stdin"
+			data.Input, err = io.ReadAll(os.Stdin)
 		} else {
-			fmt.Printf("  Part 2: %d", answer)
-			if data.Expect2 != 0 {
-				if answer == data.Expect2 {
-					fmt.Print(" (Pass)")
-				} else {
-					fmt.Print(" (Fail)")
-				}
-			}
-			fmt.Println()
+			data.Input, err = os.ReadFile(path)
 		}
-		part2Took := time.Since(part2Start)
-
-		fmt.Printf("Parse: %s Part 1: %s Part 2: %s\n", parseTook, part1Took, part2Took)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read input: %w", err)
+		}
+		if expect1 != "" {
+			data.Expect1 = solutions.Parse(expect1)
+		}
+		if expect2 != "" {
+			data.Expect2 = solutions.Parse(expect2)
+		}
+		datas = append(datas, data)
 	}
+	return
 }
 
-func utilMain() {
+var (
+	inputs Inputs
+
+	format       = flag.String("format", "text", "output format: text, json or ndjson")
+	benchRuns    = flag.Int("bench", 0, "benchmark each dataset over `N` runs instead of checking answers")
+	baselinePath = flag.String("baseline", "", "compare benchmarks against the baseline saved in `file`")
+	savePath     = flag.String("save-baseline", "", "save benchmarks to `file` for later comparison")
+	answersPath  = flag.String("answers", "answers.json", "read expected answers from `file`, overriding those in code")
+	record       = flag.Bool("record", false, "save the answers from this run to the answers file")
+	force        = flag.Bool("force", false, "let -record replace expected answers the run failed against")
+	timeout      = flag.Duration("timeout", time.Minute, "give up on a part after `duration`; 0 waits forever")
+	threshold    = flag.Float64("threshold", 10, "percent a median may slow down before it counts as a regression")
+	expect1      = flag.String("expect1", "", "expected part 1 answer for every -input")
+	expect2      = flag.String("expect2", "", "expected part 2 answer for every -input")
+)
+
+func init() {
+	flag.Var(&inputs, "input", "run against the input in `path` instead of the embedded data; - reads stdin, repeatable")
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "scaffold":
+			scaffoldMain(os.Args[2:])
+			return
+		case "fetch":
+			fetchMain(os.Args[2:])
+			return
+		case "submit":
+			submitMain(os.Args[2:])
+			return
+		case "examples":
+			examplesMain(os.Args[2:])
+			return
+		case "lock", "unlock":
+			lockMain(os.Args[1], os.Args[2:])
+			return
+		}
+	}
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage, filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
-	testKey := flag.Arg(0)
-	if testKey == "" {
-		fmt.Println("Valid tests are:")
-		for k := range utilSolutions {
-			fmt.Printf(" - %d\n", k)
+	sel, err := ParseSelection(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var print func(io.Writer, []Result) error
+	switch *format {
+	case "text":
+		print = PrintTable
+	case "json":
+		print = PrintJSON
+	case "ndjson":
+		print = PrintNDJSON
+	default:
+		fmt.Fprintf(os.Stderr, "invalid format: %s\n", *format)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *benchRuns < 0 {
+		fmt.Fprintf(os.Stderr, "invalid bench runs: %d\n", *benchRuns)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	selected := make([]solutions.Solution, 0, 64)
+	for _, solution := range solutions.All() {
+		if sel.Match(solution.Meta()) {
+			selected = append(selected, solution)
+		}
+	}
+
+	if len(selected) == 0 {
+		fmt.Fprintln(os.Stderr, "No matching puzzles found")
+		os.Exit(1)
+	}
+
+	// nil runs each solution against its own datasets
+	var custom []solutions.Data
+	switch {
+	case len(inputs) > 0 && len(selected) > 1:
+		fmt.Fprintln(os.Stderr, "-input needs a single puzzle: YEAR DAY")
+		os.Exit(2)
+	case len(inputs) > 0 && *record:
+		fmt.Fprintln(os.Stderr, "-record cannot be used with -input")
+		os.Exit(2)
+	case *force && !*record:
+		fmt.Fprintln(os.Stderr, "-force needs -record")
+		os.Exit(2)
+	case len(inputs) == 0 && (*expect1 != "" || *expect2 != ""):
+		fmt.Fprintln(os.Stderr, "-expect1 and -expect2 need -input")
+		os.Exit(2)
+	case len(inputs) > 0:
+		if custom, err = inputs.Datas(*expect1, *expect2); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
 		}
+	}
+
+	if *benchRuns > 0 {
+		benchMain(selected, custom)
 		return
 	}
 
-	key, err := strconv.Atoi(testKey)
+	manifest, err := LoadManifest(*answersPath)
 	if err != nil {
-		log.Fatalf("Failed to parse first arg: %v", err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
-	solution, ok := utilSolutions[key]
-	if !ok {
-		log.Fatalf("Solution not found for key: %d", key)
+	results := make([]Result, 0, 64)
+	for _, solution := range selected {
+		datas := custom
+		if datas == nil {
+			datas = manifest.Datas(solution.Meta())
+		}
+		results = append(results, Run(solution, datas, *timeout)...)
 	}
 
-	var r io.Reader = os.Stdin
-	if basename := flag.Arg(1); basename != "" {
-		filename := basename + ".txt"
-		data, err := solution.Files().ReadFile(filename)
-		if err != nil {
-			log.Fatalf("Unable to get data for %s: %v", basename, err)
+	if *record {
+		for _, r := range manifest.Record(results, *force) {
+			fmt.Fprintf(os.Stderr, "not recording %d/%02d %s: an expected answer failed, use -force to replace it\n", r.Year, r.Problem, r.Dataset)
+		}
+		if err := manifest.Save(*answersPath); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to save answers: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if err := print(os.Stdout, results); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write results: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, result := range results {
+		if result.Failed() {
+			os.Exit(1)
+		}
+	}
+}
+
+func benchMain(selected []solutions.Solution, custom []solutions.Data) {
+	var baseline Baseline
+	if *baselinePath != "" {
+		var err error
+		if baseline, err = LoadBaseline(*baselinePath); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	benches := make([]Bench, 0, 64)
+	for _, solution := range selected {
+		datas := custom
+		if datas == nil {
+			datas = solution.Meta().Datas
 		}
-		r = bytes.NewReader(data)
+		benches = append(benches, Benchmark(solution, datas, *benchRuns, *timeout)...)
 	}
 
-	parseStart := time.Now()
-	if err = solution.Parse(r); err != nil {
-		log.Fatalf("Unable to parse input: %v", err)
+	var err error
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(benches)
+	case "ndjson":
+		enc := json.NewEncoder(os.Stdout)
+		for _, b := range benches {
+			if err = enc.Encode(b); err != nil {
+				break
+			}
+		}
+	default:
+		err = PrintBenchTable(os.Stdout, benches, baseline)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write benchmarks: %v\n", err)
+		os.Exit(1)
 	}
-	parseTook := time.Since(parseStart)
 
-	part1Start := time.Now()
-	if err = solution.Part1(os.Stdout); err != nil {
-		log.Fatalf("Unable to solve part 1: %v", err)
+	for _, b := range benches {
+		if b.Failed != "" {
+			os.Exit(1)
+		}
 	}
-	part1Took := time.Since(part1Start)
 
-	part2Start := time.Now()
-	if err = solution.Part2(os.Stdout); err != nil {
-		log.Fatalf("Unable to solve part 2: %v", err)
+	if *savePath != "" {
+		if err := SaveBaseline(*savePath, benches); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to save baseline: %v\n", err)
+			os.Exit(1)
+		}
 	}
-	part2Took := time.Since(part2Start)
 
-	fmt.Printf("\nParse: %s Part 1: %s Part 2: %s\n", parseTook, part1Took, part2Took)
+	if regressions := baseline.Regressions(benches, *threshold/100); len(regressions) > 0 {
+		fmt.Fprintf(os.Stderr, "%d regressions beyond %g%%:\n", len(regressions), *threshold)
+		for _, r := range regressions {
+			fmt.Fprintf(os.Stderr, "  %s\n", r)
+		}
+		os.Exit(1)
+	}
 }
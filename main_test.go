@@ -0,0 +1,120 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+func TestParseSelection(t *testing.T) {
+	tests := []struct {
+		args   string
+		expect Selection
+	}{
+		{"all", Selection{}},
+		{"2022", Selection{Year: 2022}},
+		{"2022 7", Selection{Year: 2022, First: 7, Last: 7}},
+		{"2022 07", Selection{Year: 2022, First: 7, Last: 7}},
+		{"2022 3-9", Selection{Year: 2022, First: 3, Last: 9}},
+		{"2022 4-4", Selection{Year: 2022, First: 4, Last: 4}},
+	}
+	for _, test := range tests {
+		got, err := ParseSelection(strings.Fields(test.args))
+		if err != nil || got != test.expect {
+			t.Errorf("%q: got %+v, %v, expected %+v", test.args, got, err, test.expect)
+		}
+	}
+
+	for _, args := range []string{"", "2022 1 2", "all 2022", "twenty", "2022 x", "2022 9-3", "2022 3-x", "2022 3,4"} {
+		if got, err := ParseSelection(strings.Fields(args)); err == nil {
+			t.Errorf("%q: got %+v, expected an error", args, got)
+		}
+	}
+}
+
+func TestSelectionMatch(t *testing.T) {
+	metas := []solutions.Meta{
+		{Year: 2021, Problem: 3},
+		{Year: 2022, Problem: 1},
+		{Year: 2022, Problem: 5},
+		{Year: 2022, Problem: 10},
+	}
+	tests := []struct {
+		args   string
+		expect []int
+	}{
+		{"all", []int{0, 1, 2, 3}},
+		{"2022", []int{1, 2, 3}},
+		{"2022 5", []int{2}},
+		{"2022 2-10", []int{2, 3}},
+		{"2021 5", nil},
+	}
+	for _, test := range tests {
+		sel, err := ParseSelection(strings.Fields(test.args))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int
+		for i, meta := range metas {
+			if sel.Match(meta) {
+				got = append(got, i)
+			}
+		}
+		if !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("%q: got %v, expected %v", test.args, got, test.expect)
+		}
+	}
+}
+
+// TestInputs checks that -input replaces the puzzle's own datasets and that
+// -expect1 and -expect2 apply to each of them
+func TestInputs(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mine.txt")
+	if err := os.WriteFile(path, []byte("twelve chars"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdin, err := os.CreateTemp(dir, "stdin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin.WriteString("piped")
+	stdin.Seek(0, io.SeekStart)
+	saved := os.Stdin
+	os.Stdin = stdin
+	t.Cleanup(func() { os.Stdin = saved })
+
+	datas, err := Inputs{path, "-"}.Datas("12", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []solutions.Data{
+		{Name: path, File: path, Input: []byte("twelve chars"), Expect1: solutions.Int(12)},
+		{Name: "stdin", File: "stdin", Input: []byte("piped"), Expect1: solutions.Int(12)},
+	}
+	if !reflect.DeepEqual(datas, expect) {
+		t.Fatalf("got %+v, expected %+v", datas, expect)
+	}
+
+	results := Run(new(scripted), datas, time.Second)
+	if len(results) != 2 || results[0].Dataset != path || results[1].Dataset != "stdin" {
+		t.Fatalf("got %+v, expected one result for each input", results)
+	}
+	if results[0].Status1() != StatusPass || results[0].Status2() != StatusUnknown {
+		t.Errorf("%s: got %s and %s, expected a pass and no expectation", path, results[0].Status1(), results[0].Status2())
+	}
+	if results[1].Status1() != StatusFail {
+		t.Errorf("stdin: got %s, expected a fail", results[1].Status1())
+	}
+
+	if _, err := (Inputs{filepath.Join(dir, "missing.txt")}).Datas("", ""); err == nil {
+		t.Error("expected an error for a missing input")
+	}
+}
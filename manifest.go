@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+const (
+	part1Key = "part1"
+	part2Key = "part2"
+)
+
+// Expected maps part1 and part2 to their answers. A part that is missing
+// falls back to the expectation in code; null marks it explicitly unknown.
+type Expected map[string]solutions.Answer
+
+// Manifest holds expected answers outside the source, keyed by "YYYY/DD",
+// then by dataset name
+type Manifest map[string]map[string]Expected
+
+func manifestKey(year int, problem int) string {
+	return fmt.Sprintf("%d/%02d", year, problem)
+}
+
+// LoadManifest reads the manifest at path. A missing file is an empty
+// manifest so the first -record can create it.
+func LoadManifest(path string) (manifest Manifest, err error) {
+	manifest = make(Manifest)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(data, &manifest); err != nil {
+		err = fmt.Errorf("unable to read manifest %s: %w", path, err)
+	}
+	return
+}
+
+func (m Manifest) Save(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Datas returns the solution's datasets with the manifest's expectations in
+// place of those in code. The runner, submit and the golden test all run
+// these.
+func (m Manifest) Datas(meta solutions.Meta) []solutions.Data {
+	datas := make([]solutions.Data, len(meta.Datas))
+	copy(datas, meta.Datas)
+	for i := range datas {
+		expected := m[manifestKey(meta.Year, meta.Problem)][datas[i].Name]
+		if answer, ok := expected[part1Key]; ok {
+			datas[i].Expect1 = answer
+		}
+		if answer, ok := expected[part2Key]; ok {
+			datas[i].Expect2 = answer
+		}
+	}
+	return datas
+}
+
+// Set stores one expected answer; part is 1 or 2
+func (m Manifest) Set(year int, problem int, dataset string, part int, answer solutions.Answer) {
+	key := manifestKey(year, problem)
+	if m[key] == nil {
+		m[key] = make(map[string]Expected)
+	}
+	if m[key][dataset] == nil {
+		m[key][dataset] = make(Expected)
+	}
+	m[key][dataset][fmt.Sprintf("part%d", part)] = answer
+}
+
+// Record stores the answers produced without error where the expectation is
+// missing or null. A known answer the run FAILed against is only replaced with
+// force; otherwise it is returned so a regression never becomes the new
+// expectation unnoticed.
+func (m Manifest) Record(results []Result, force bool) (refused []Result) {
+	for _, r := range results {
+		if r.ParseErr != nil {
+			continue
+		}
+		record1 := r.Part1Err == nil && !r.Answer1.IsZero()
+		record2 := r.Part2Err == nil && !r.Answer2.IsZero()
+		if !force && (r.Status1() == StatusFail || r.Status2() == StatusFail) {
+			refused = append(refused, r)
+			record1 = record1 && r.Status1() != StatusFail
+			record2 = record2 && r.Status2() != StatusFail
+		}
+		if record1 {
+			m.Set(r.Year, r.Problem, r.Dataset, 1, r.Answer1)
+		}
+		if record2 {
+			m.Set(r.Year, r.Problem, r.Dataset, 2, r.Answer2)
+		}
+	}
+	return
+}
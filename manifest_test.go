@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		before  Expected
+		answer  solutions.Answer
+		err     error
+		force   bool
+		after   Expected
+		refused bool
+	}{
+		{
+			name:   "missing is filled",
+			before: nil,
+			answer: solutions.Int(42),
+			after:  Expected{part1Key: solutions.Int(42)},
+		},
+		{
+			name:   "null is filled",
+			before: Expected{part1Key: {}},
+			answer: solutions.Int(42),
+			after:  Expected{part1Key: solutions.Int(42)},
+		},
+		{
+			name:   "passing answer is left alone",
+			before: Expected{part1Key: solutions.Int(42)},
+			answer: solutions.Int(42),
+			after:  Expected{part1Key: solutions.Int(42)},
+		},
+		{
+			name:    "failing answer is refused",
+			before:  Expected{part1Key: solutions.Int(42)},
+			answer:  solutions.Int(1),
+			after:   Expected{part1Key: solutions.Int(42)},
+			refused: true,
+		},
+		{
+			name:   "failing answer is replaced with force",
+			before: Expected{part1Key: solutions.Int(42)},
+			answer: solutions.Int(1),
+			force:  true,
+			after:  Expected{part1Key: solutions.Int(1)},
+		},
+		{
+			name:   "no answer is not recorded",
+			before: Expected{part1Key: {}},
+			after:  Expected{part1Key: {}},
+		},
+		{
+			name:   "error is not recorded",
+			before: nil,
+			answer: solutions.Int(42),
+			err:    fmt.Errorf("no route"),
+			after:  nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest := make(Manifest)
+			if test.before != nil {
+				manifest[manifestKey(2000, 1)] = map[string]Expected{inputDataset: test.before}
+			}
+			result := Result{
+				Year:     2000,
+				Problem:  1,
+				Dataset:  inputDataset,
+				Answer1:  test.answer,
+				Expect1:  test.before[part1Key],
+				Part1Err: test.err,
+			}
+
+			refused := manifest.Record([]Result{result}, test.force)
+			if got := manifest[manifestKey(2000, 1)][inputDataset]; !reflect.DeepEqual(got, test.after) {
+				t.Errorf("got %v, expected %v", got, test.after)
+			}
+			if (len(refused) > 0) != test.refused {
+				t.Errorf("got %d refused, expected refused to be %t", len(refused), test.refused)
+			}
+		})
+	}
+}
+
+// TestManifestNull checks that an unknown answer survives a save and reload as
+// null rather than turning into, or being confused with, an answer of 0
+func TestManifestNull(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	manifest := make(Manifest)
+	manifest.Set(2000, 1, inputDataset, 1, solutions.Answer{})
+	manifest.Set(2000, 1, inputDataset, 2, solutions.Int(0))
+	if err := manifest.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{`"part1": null`, `"part2": "0"`} {
+		if !strings.Contains(string(data), expect) {
+			t.Errorf("saved manifest is missing %s:\n%s", expect, data)
+		}
+	}
+
+	loaded, err := LoadManifest(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := loaded[manifestKey(2000, 1)][inputDataset]
+	if answer, ok := expected[part1Key]; !ok || !answer.IsZero() {
+		t.Errorf("part 1: got %#v, %t, expected null", answer, ok)
+	}
+	if answer := expected[part2Key]; answer.IsZero() || !answer.Equal(solutions.Int(0)) {
+		t.Errorf("part 2: got %#v, expected 0", answer)
+	}
+}
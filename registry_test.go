@@ -0,0 +1,15 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/internal/registry"
+)
+
+// TestRegistry fails when a puzzle directory is missing from registry_gen.go,
+// which would otherwise leave it out of every run without a word
+func TestRegistry(t *testing.T) {
+	if err := registry.Check("."); err != nil {
+		t.Fatal(err)
+	}
+}
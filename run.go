@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/internal/vault"
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+const (
+	StatusPass    = "pass"
+	StatusFail    = "fail"
+	StatusUnknown = "unknown"
+	StatusTimeout = "timeout"
+	StatusError   = "error"
+	StatusLocked  = "locked"
+)
+
+// Result holds the outcome of running both parts against a single dataset
+type Result struct {
+	Year    int
+	Problem int
+	Name    string
+	Dataset string
+	Answer1 solutions.Answer
+	Answer2 solutions.Answer
+	Expect1 solutions.Answer
+	Expect2 solutions.Answer
+	Parse   time.Duration
+	Part1   time.Duration
+	Part2   time.Duration
+
+	ParseErr error
+	Part1Err error
+	Part2Err error
+}
+
+// A part that never ran because parsing failed reports the parse error
+func (r Result) Status1() string {
+	if r.ParseErr != nil {
+		return parseStatus(r.ParseErr)
+	}
+	return status(r.Answer1, r.Expect1, r.Part1Err)
+}
+
+func (r Result) Status2() string {
+	if r.ParseErr != nil {
+		return parseStatus(r.ParseErr)
+	}
+	return status(r.Answer2, r.Expect2, r.Part2Err)
+}
+
+// Locked reports a sealed input with no key to open it. Nothing ran, but
+// nothing went wrong either.
+func (r Result) Locked() bool {
+	return errors.Is(r.ParseErr, vault.ErrLocked)
+}
+
+// Errs lines up with phaseNames
+func (r Result) Errs() [3]error {
+	return [3]error{r.ParseErr, r.Part1Err, r.Part2Err}
+}
+
+func (r Result) Failed() bool {
+	if r.Locked() {
+		return false
+	}
+	if r.ParseErr != nil || r.Part1Err != nil || r.Part2Err != nil {
+		return true
+	}
+	return r.Status1() == StatusFail || r.Status2() == StatusFail
+}
+
+func parseStatus(err error) string {
+	if errors.Is(err, vault.ErrLocked) {
+		return StatusLocked
+	}
+	return StatusError
+}
+
+func status(answer solutions.Answer, expect solutions.Answer, err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return StatusTimeout
+	case err != nil:
+		return StatusError
+	}
+	if expect.IsZero() {
+		return StatusUnknown
+	}
+	if answer.Equal(expect) {
+		return StatusPass
+	}
+	return StatusFail
+}
+
+// PanicError stands in for a panic raised by a solution
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Run parses and solves each dataset in order, usually the solution's own
+// meta.Datas. Errors and panics are recorded against the dataset and part they
+// came from, and the run carries on. Each part gets at most timeout, or
+// forever when timeout is zero. The registered instance itself is never
+// parsed into, so state cannot leak between runs.
+func Run(solution solutions.Solution, datas []solutions.Data, timeout time.Duration) (results []Result) {
+	meta := solution.Meta()
+	solution = solutions.New(solution)
+	results = make([]Result, 0, len(datas))
+	for _, data := range datas {
+		result := newResult(meta, data)
+		input, elapsed, err := parseInto(solution, data)
+		result.Parse, result.ParseErr = elapsed, err
+		if err != nil {
+			// Half-parsed state must not leak into the next dataset
+			solution = solutions.New(solution)
+			results = append(results, result)
+			continue
+		}
+
+		result.Answer1, result.Part1, result.Part1Err = runPart(solution.Part1, timeout)
+		if abandoned(result.Part1Err) {
+			solution = solutions.New(solution)
+			if err := protect(func() error { return solution.Parse(input) }); err != nil {
+				result.Part2Err = fmt.Errorf("unable to parse data again: %w", err)
+				results = append(results, result)
+				continue
+			}
+		}
+
+		result.Answer2, result.Part2, result.Part2Err = runPart(solution.Part2, timeout)
+		if abandoned(result.Part2Err) {
+			solution = solutions.New(solution)
+		}
+
+		results = append(results, result)
+	}
+	return
+}
+
+// RunPart parses a fresh instance with data and runs only part, 1 or 2. The
+// other part of the result is left empty.
+func RunPart(solution solutions.Solution, data solutions.Data, part int, timeout time.Duration) (result Result) {
+	result = newResult(solution.Meta(), data)
+	solution = solutions.New(solution)
+	if _, result.Parse, result.ParseErr = parseInto(solution, data); result.ParseErr != nil {
+		return
+	}
+	if part == 1 {
+		result.Answer1, result.Part1, result.Part1Err = runPart(solution.Part1, timeout)
+	} else {
+		result.Answer2, result.Part2, result.Part2Err = runPart(solution.Part2, timeout)
+	}
+	return
+}
+
+func newResult(meta solutions.Meta, data solutions.Data) Result {
+	return Result{
+		Year:    meta.Year,
+		Problem: meta.Problem,
+		Name:    meta.Name,
+		Dataset: data.Name,
+		Expect1: data.Expect1,
+		Expect2: data.Expect2,
+	}
+}
+
+// parseInto opens data and parses it into solution, placing parse errors in
+// the dataset's file
+func parseInto(solution solutions.Solution, data solutions.Data) (input []byte, elapsed time.Duration, err error) {
+	if input, err = vault.Open(data.Input); err != nil {
+		return
+	}
+	start := time.Now()
+	err = protect(func() error { return solution.Parse(input) })
+	elapsed = time.Since(start)
+	if err != nil {
+		err = util.InFile(err, data.Filename())
+	}
+	return
+}
+
+// runPart calls part in the background so a part that ignores its context
+// can still be abandoned once the deadline passes
+func runPart(part func(context.Context) (solutions.Answer, error), timeout time.Duration) (answer solutions.Answer, elapsed time.Duration, err error) {
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+
+	type outcome struct {
+		answer solutions.Answer
+		err    error
+	}
+	done := make(chan outcome, 1)
+	start := time.Now()
+	go func() {
+		var o outcome
+		o.err = protect(func() (err error) {
+			o.answer, err = part(ctx)
+			return
+		})
+		done <- o
+	}()
+
+	select {
+	case o := <-done:
+		answer, err = o.answer, o.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	elapsed = time.Since(start)
+	if errors.Is(err, context.DeadlineExceeded) {
+		answer, err = solutions.Answer{}, fmt.Errorf("timed out after %s: %w", timeout, context.DeadlineExceeded)
+	}
+	return
+}
+
+func protect(fn func() error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = &PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+	return fn()
+}
+
+// After a timeout the part may still be running, and after a panic the
+// instance may be left half-updated; either way it cannot be used again
+func abandoned(err error) bool {
+	var panicErr *PanicError
+	return errors.Is(err, context.DeadlineExceeded) || errors.As(err, &panicErr)
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+// hang holds up a scripted part 1 until the test is over
+var hang chan struct{}
+
+// scripted acts out its input: "bad" fails to parse, then in part 1 "hang"
+// ignores its context, "panic" panics and anything else answers with the
+// input's length. Part 2 answers the same way, unless it is handed an instance
+// part 1 was abandoned on.
+type scripted struct {
+	input   string
+	touched bool
+}
+
+func (*scripted) Meta() solutions.Meta {
+	return solutions.Meta{Name: "scripted", Year: 2000, Problem: 1}
+}
+
+func (s *scripted) Parse(data []byte) error {
+	if string(data) == "bad" {
+		return fmt.Errorf("no script")
+	}
+	s.input = string(data)
+	return nil
+}
+
+func (s *scripted) Part1(context.Context) (solutions.Answer, error) {
+	switch s.input {
+	case "hang":
+		s.touched = true
+		<-hang
+	case "panic":
+		s.touched = true
+		panic("scripted")
+	}
+	return solutions.Int(len(s.input)), nil
+}
+
+func (s *scripted) Part2(context.Context) (solutions.Answer, error) {
+	if s.touched {
+		return solutions.Answer{}, fmt.Errorf("part 2 got the instance part 1 ran on")
+	}
+	return solutions.Int(len(s.input)), nil
+}
+
+// TestRun checks that a part that times out or panics is abandoned, that part
+// 2 then runs on a freshly parsed instance, that later datasets still run
+// after any error and that the summary counts each outcome once
+func TestRun(t *testing.T) {
+	hang = make(chan struct{})
+	t.Cleanup(func() { close(hang) })
+
+	datas := []solutions.Data{
+		{Name: "Hang", Input: []byte("hang"), Expect2: solutions.Int(4)},
+		{Name: "Bad", Input: []byte("bad")},
+		{Name: "Panic", Input: []byte("panic"), Expect2: solutions.Int(5)},
+		{Name: "Wrong", Input: []byte("wrong"), Expect1: solutions.Int(5), Expect2: solutions.Int(1)},
+		{Name: "Fine", Input: []byte("fine"), Expect1: solutions.Int(4), Expect2: solutions.Int(4)},
+	}
+	results := Run(new(scripted), datas, 50*time.Millisecond)
+	if len(results) != len(datas) {
+		t.Fatalf("got %d results, expected %d", len(results), len(datas))
+	}
+
+	hung := results[0]
+	if hung.Status1() != StatusTimeout || !errors.Is(hung.Part1Err, context.DeadlineExceeded) {
+		t.Errorf("hang part 1: got %s, %v, expected a timeout", hung.Status1(), hung.Part1Err)
+	}
+	if hung.Status2() != StatusPass {
+		t.Errorf("hang part 2: got %s, %v, expected a pass on a fresh instance", hung.Status2(), hung.Part2Err)
+	}
+
+	bad := results[1]
+	if bad.ParseErr == nil || bad.Status1() != StatusError || bad.Status2() != StatusError {
+		t.Errorf("bad: got %s, %s, %v, expected a parse error", bad.Status1(), bad.Status2(), bad.ParseErr)
+	}
+
+	panicked := results[2]
+	var panicErr *PanicError
+	if !errors.As(panicked.Part1Err, &panicErr) || panicErr.Value != "scripted" || len(panicErr.Stack) == 0 {
+		t.Errorf("panic part 1: got %#v, expected a *PanicError with a stack", panicked.Part1Err)
+	}
+	if panicked.Status1() != StatusError {
+		t.Errorf("panic part 1: got %s, expected %s", panicked.Status1(), StatusError)
+	}
+	if panicked.Status2() != StatusPass {
+		t.Errorf("panic part 2: got %s, %v, expected a pass on a fresh instance", panicked.Status2(), panicked.Part2Err)
+	}
+
+	wrong := results[3]
+	if wrong.Status1() != StatusPass || wrong.Status2() != StatusFail {
+		t.Errorf("wrong: got %s and %s, expected a pass and a fail", wrong.Status1(), wrong.Status2())
+	}
+
+	fine := results[4]
+	if fine.Status1() != StatusPass || fine.Status2() != StatusPass {
+		t.Errorf("fine: got %s and %s, expected both to pass", fine.Status1(), fine.Status2())
+	}
+
+	var out strings.Builder
+	if err := PrintTable(&out, results); err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{
+		"2000/01 scripted Bad parse: bad.txt: no script\n",
+		"2000/01 scripted Panic part 1: panic: scripted\n",
+		"5 datasets: 5 parts passed, 1 failed, 1 timed out, 1 errored; 1 datasets failed to parse\n",
+	} {
+		if !strings.Contains(out.String(), expect) {
+			t.Errorf("table is missing %q:\n%s", expect, out.String())
+		}
+	}
+}
+
+// TestRunPart checks that only the requested part runs, so part 2 answers
+// even though part 1 would hang
+func TestRunPart(t *testing.T) {
+	data := solutions.Data{Name: "Hang", Input: []byte("hang"), Expect2: solutions.Int(4)}
+	r := RunPart(new(scripted), data, 2, time.Second)
+	if r.Status2() != StatusPass || r.Part1 != 0 || !r.Answer1.IsZero() {
+		t.Errorf("got part 1 %s in %s and part 2 %s, %v, expected only part 2 to run", r.Answer1, r.Part1, r.Status2(), r.Part2Err)
+	}
+
+	r = RunPart(new(scripted), solutions.Data{Name: "Bad", Input: []byte("bad")}, 1, time.Second)
+	if r.ParseErr == nil || r.Status1() != StatusError {
+		t.Errorf("got %s, %v, expected a parse error", r.Status1(), r.ParseErr)
+	}
+}
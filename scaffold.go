@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"fmt"
+	goformat "go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/jbaikge/advent-of-code/internal/registry"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+var scaffoldName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// Scaffold describes a new puzzle; Name is the kebab-case directory suffix
+type Scaffold struct {
+	Year int
+	Day  int
+	Name string
+}
+
+func (s Scaffold) Dir() string {
+	return fmt.Sprintf("%04d/%02d-%s", s.Year, s.Day, s.Name)
+}
+
+func (s Scaffold) Package() string {
+	return strings.ReplaceAll(s.Name, "-", "")
+}
+
+func (s Scaffold) Title() string {
+	return strings.ReplaceAll(s.Name, "-", " ")
+}
+
+// Create makes the puzzle directory with empty test.txt and input.txt, then
+// renders every *.tmpl in tmpls into it with the .tmpl suffix removed
+func (s Scaffold) Create(tmpls fs.FS) (err error) {
+	dir := filepath.FromSlash(s.Dir())
+	if _, err = os.Stat(dir); err == nil {
+		return fmt.Errorf("directory already exists: %s", dir)
+	}
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+
+	for _, name := range []string{"test.txt", "input.txt"} {
+		if err = os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			return
+		}
+	}
+
+	names, err := fs.Glob(tmpls, "*.tmpl")
+	if err != nil {
+		return
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no templates found")
+	}
+	for _, name := range names {
+		tmpl, err := template.ParseFS(tmpls, name)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err = tmpl.Execute(&buf, s); err != nil {
+			return err
+		}
+		out := buf.Bytes()
+		target := strings.TrimSuffix(name, ".tmpl")
+		if strings.HasSuffix(target, ".go") {
+			if out, err = goformat.Source(out); err != nil {
+				return fmt.Errorf("template %s: %w", name, err)
+			}
+		}
+		if err = os.WriteFile(filepath.Join(dir, target), out, 0o644); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+func scaffoldMain(args []string) {
+	flags := flag.NewFlagSet("scaffold", flag.ExitOnError)
+	tmplDir := flags.String("templates", "", "render the *.tmpl files in `dir` instead of the built-in templates")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s scaffold [flags] YEAR DAY NAME\n", filepath.Base(os.Args[0]))
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 3 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var s Scaffold
+	var err error
+	if s.Year, s.Day, err = parseDay(flags.Arg(0), flags.Arg(1)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if s.Name = flags.Arg(2); !scaffoldName.MatchString(s.Name) {
+		fmt.Fprintf(os.Stderr, "invalid name, use lowercase words joined by dashes: %s\n", s.Name)
+		os.Exit(2)
+	}
+
+	var tmpls fs.FS
+	if tmpls, err = fs.Sub(templates, "templates"); err != nil {
+		panic(err)
+	}
+	if *tmplDir != "" {
+		tmpls = os.DirFS(*tmplDir)
+	}
+
+	if err = s.Create(tmpls); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err = registry.Write("."); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Created %s\n", s.Dir())
+}
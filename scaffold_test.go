@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// inTempDir runs the rest of the test from an empty directory, as Create works
+// relative to the current one
+func inTempDir(t *testing.T) (root string, dir string) {
+	t.Helper()
+	root, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir = t.TempDir()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(root) })
+	return
+}
+
+func builtinTemplates(t *testing.T) fs.FS {
+	t.Helper()
+	tmpls, err := fs.Sub(templates, "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tmpls
+}
+
+// TestScaffoldBuilds scaffolds a puzzle into a module of its own that points
+// back at this one, then vets and tests the generated package
+func TestScaffoldBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go build in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not on the path")
+	}
+
+	root, dir := inTempDir(t)
+	mod := fmt.Sprintf("module scaffolded\n\ngo 1.19\n\nrequire github.com/jbaikge/advent-of-code v0.0.0\n\nreplace github.com/jbaikge/advent-of-code => %s\n", root)
+	if err := os.WriteFile("go.mod", []byte(mod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s := Scaffold{Year: 2000, Day: 7, Name: "new-puzzle"}
+	if err := s.Create(builtinTemplates(t)); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"test.txt", "input.txt", "solution.go", "fuzz_test.go"} {
+		if _, err := os.Stat(filepath.Join(dir, "2000", "07-new-puzzle", name)); err != nil {
+			t.Error(err)
+		}
+	}
+
+	for _, args := range [][]string{{"vet", "./..."}, {"test", "./..."}} {
+		cmd := exec.Command(goTool, args...)
+		cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("go %s: %v\n%s", args[0], err, out)
+		}
+	}
+}
+
+func TestScaffoldExists(t *testing.T) {
+	_, dir := inTempDir(t)
+	s := Scaffold{Year: 2000, Day: 7, Name: "new-puzzle"}
+	existing := filepath.Join(dir, "2000", "07-new-puzzle")
+	if err := os.MkdirAll(existing, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	solution := filepath.Join(existing, "solution.go")
+	if err := os.WriteFile(solution, []byte("package newpuzzle\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Create(builtinTemplates(t)); err == nil {
+		t.Fatal("expected an existing directory to be refused")
+	}
+	if data, err := os.ReadFile(solution); err != nil || string(data) != "package newpuzzle\n" {
+		t.Errorf("got %q, %v, expected solution.go to be left alone", data, err)
+	}
+}
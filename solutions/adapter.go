@@ -0,0 +1,139 @@
+package solutions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+// Adapter wraps a util.Solution, which writes its answers to an io.Writer,
+// so it can be registered and run alongside native solutions
+type Adapter struct {
+	Name     string
+	Year     int
+	Problem  int
+	Solution util.Solution
+}
+
+func Adapt(year int, problem int, name string, s util.Solution) *Adapter {
+	return &Adapter{
+		Name:     name,
+		Year:     year,
+		Problem:  problem,
+		Solution: s,
+	}
+}
+
+// Meta builds the datasets from the embedded files: test*.txt first, then
+// input*.txt
+func (a *Adapter) Meta() Meta {
+	meta := Meta{
+		Name:    a.Name,
+		Year:    a.Year,
+		Problem: a.Problem,
+	}
+
+	files := a.Solution.Files()
+	for _, prefix := range []string{"test", "input"} {
+		names, _ := fs.Glob(files, prefix+"*.txt")
+		for _, name := range names {
+			input, err := files.ReadFile(name)
+			if err != nil {
+				continue
+			}
+			meta.Datas = append(meta.Datas, Data{
+				Name:  dataName(name),
+				File:  name,
+				Input: input,
+			})
+		}
+	}
+
+	return meta
+}
+
+func (a *Adapter) Parse(data []byte) error {
+	return a.Solution.Parse(bytes.NewReader(data))
+}
+
+// Only util.Cancellable solutions see ctx; the parts of any other
+// util.Solution cannot be cancelled, so ctx is ignored. A part that returns
+// util.ErrUnsolved has no answer.
+func (a *Adapter) Part1(ctx context.Context) (answer Answer, err error) {
+	var buf bytes.Buffer
+	if c, ok := a.Solution.(util.Cancellable); ok {
+		err = c.Part1Context(ctx, &buf)
+	} else {
+		err = a.Solution.Part1(&buf)
+	}
+	if errors.Is(err, util.ErrUnsolved) {
+		return Answer{}, nil
+	}
+	if err != nil {
+		return
+	}
+	return parseAnswer(buf.String())
+}
+
+func (a *Adapter) Part2(ctx context.Context) (answer Answer, err error) {
+	var buf bytes.Buffer
+	if c, ok := a.Solution.(util.Cancellable); ok {
+		err = c.Part2Context(ctx, &buf)
+	} else {
+		err = a.Solution.Part2(&buf)
+	}
+	if errors.Is(err, util.ErrUnsolved) {
+		return Answer{}, nil
+	}
+	if err != nil {
+		return
+	}
+	return parseAnswer(buf.String())
+}
+
+func (a *Adapter) fresh() Solution {
+	fresh := *a
+	fresh.Solution = zeroOf(a.Solution).(util.Solution)
+	return &fresh
+}
+
+// test.txt -> Test, test2.txt -> Test 2, input.txt -> Input
+func dataName(filename string) string {
+	base := strings.TrimSuffix(filename, ".txt")
+	name := strings.TrimRight(base, "0123456789")
+	name = strings.ToUpper(name[:1]) + name[1:]
+	if suffix := base[len(name):]; suffix != "" {
+		name += " " + suffix
+	}
+	return name
+}
+
+// Output takes the form "Part 1: 1234"; the answer is whatever follows the
+// colon. Anything written on the lines after it is treated as a render.
+func parseAnswer(output string) (answer Answer, err error) {
+	output = strings.TrimSpace(output)
+	if output == "" {
+		err = fmt.Errorf("no answer written")
+		return
+	}
+
+	first, rest, _ := strings.Cut(output, "\n")
+	if i := strings.Index(first, ":"); i > -1 {
+		first = first[i+1:]
+	}
+	first = strings.TrimSpace(first)
+
+	switch {
+	case rest != "":
+		return Render(first + "\n" + rest), nil
+	case first == "":
+		err = fmt.Errorf("unable to read answer from output %q", output)
+		return
+	}
+	return Parse(first), nil
+}
@@ -0,0 +1,123 @@
+package solutions
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+// printer writes whatever it is given as the output of both parts
+type printer struct {
+	output string
+	err    error
+}
+
+func (p *printer) Files() embed.FS {
+	return embed.FS{}
+}
+
+func (p *printer) Parse(io.Reader) error {
+	return nil
+}
+
+func (p *printer) Part1(w io.Writer) error {
+	fmt.Fprint(w, p.output)
+	return p.err
+}
+
+func (p *printer) Part2(w io.Writer) error {
+	return p.Part1(w)
+}
+
+func TestAdapterAnswers(t *testing.T) {
+	huge, _ := new(big.Int).SetString("12345678901234567890", 10)
+	tests := []struct {
+		output string
+		expect Answer
+	}{
+		{"Part 1: 1234\n", Int(1234)},
+		{"Part 2: 9606140307013\n", Int(9606140307013)},
+		{"Part 1: 12345678901234567890\n", Big(huge)},
+		{"Part 1: CFLELOYFCS\n", String("CFLELOYFCS")},
+		{"  Part 1:   42  \n\n", Int(42)},
+		{"no colon 17\n", String("no colon 17")},
+		{"Part 2:\n#..#\n####\n", Render("#..#\n####")},
+	}
+	for _, test := range tests {
+		a := Adapt(2022, 1, "printer", &printer{output: test.output})
+		got, err := a.Part1(context.Background())
+		if err != nil {
+			t.Errorf("%q: %v", test.output, err)
+			continue
+		}
+		if !got.Equal(test.expect) || got.Kind != test.expect.Kind {
+			t.Errorf("%q: got %#v, expected %#v", test.output, got, test.expect)
+		}
+	}
+}
+
+func TestAdapterMissingAnswer(t *testing.T) {
+	for _, output := range []string{"", "\n\n", "Part 1:\n", "Part 1:   "} {
+		a := Adapt(2022, 1, "printer", &printer{output: output})
+		if got, err := a.Part2(context.Background()); err == nil {
+			t.Errorf("%q: got %q, expected an error", output, got)
+		}
+	}
+
+	failing := fmt.Errorf("no route")
+	a := Adapt(2022, 1, "printer", &printer{output: "Part 1: 5\n", err: failing})
+	if _, err := a.Part1(context.Background()); err != failing {
+		t.Errorf("got %v, expected the part's own error", err)
+	}
+
+	// An unsolved part has no answer rather than a placeholder or an error
+	a = Adapt(2022, 1, "printer", &printer{output: "Part 2: 0\n", err: util.ErrUnsolved})
+	if got, err := a.Part2(context.Background()); err != nil || !got.IsZero() {
+		t.Errorf("got %q, %v, expected no answer", got, err)
+	}
+}
+
+// waiter blocks until ctx is done, as a long search would
+type waiter struct {
+	printer
+}
+
+func (w *waiter) Part1Context(ctx context.Context, out io.Writer) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (w *waiter) Part2Context(ctx context.Context, out io.Writer) error {
+	return w.printer.Part2(out)
+}
+
+func TestAdapterContext(t *testing.T) {
+	a := Adapt(2022, 1, "waiter", &waiter{printer{output: "Part 2: 7\n"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := a.Part1(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, expected the part to see ctx cancelled", err)
+	}
+	if got, err := a.Part2(ctx); err != nil || !got.Equal(Int(7)) {
+		t.Errorf("got %q, %v", got, err)
+	}
+}
+
+func TestDataName(t *testing.T) {
+	for file, expect := range map[string]string{
+		"test.txt":   "Test",
+		"test2.txt":  "Test 2",
+		"input.txt":  "Input",
+		"input3.txt": "Input 3",
+	} {
+		if got := dataName(file); got != expect {
+			t.Errorf("%s: got %q, expected %q", file, got, expect)
+		}
+	}
+}
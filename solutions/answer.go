@@ -0,0 +1,122 @@
+package solutions
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type AnswerKind int
+
+const (
+	KindNone AnswerKind = iota
+	KindInt
+	KindBig
+	KindString
+	KindRender
+)
+
+// Answer carries a puzzle answer in its canonical text form. The zero value
+// means there is no answer, which for Data.Expect1/Expect2 means unknown.
+type Answer struct {
+	Kind  AnswerKind
+	Value string
+}
+
+func Int(n int) Answer {
+	return Int64(int64(n))
+}
+
+func Int64(n int64) Answer {
+	return Answer{
+		Kind:  KindInt,
+		Value: strconv.FormatInt(n, 10),
+	}
+}
+
+func Big(n *big.Int) Answer {
+	return Answer{
+		Kind:  KindBig,
+		Value: n.String(),
+	}
+}
+
+func String(s string) Answer {
+	return Answer{
+		Kind:  KindString,
+		Value: s,
+	}
+}
+
+// Render holds a multi-line answer, such as letters drawn on a screen. Lines
+// have trailing whitespace removed and surrounding blank lines are dropped.
+func Render(s string) Answer {
+	lines := strings.Split(strings.Trim(s, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return Answer{
+		Kind:  KindRender,
+		Value: strings.Join(lines, "\n"),
+	}
+}
+
+func (a Answer) IsZero() bool {
+	return a.Kind == KindNone
+}
+
+// Equal compares canonical values, so Int(42) matches Big(big.NewInt(42))
+// and String("42")
+func (a Answer) Equal(b Answer) bool {
+	if a.IsZero() || b.IsZero() {
+		return a.IsZero() && b.IsZero()
+	}
+	return a.Value == b.Value
+}
+
+// Lines splits the answer for display; only renders span more than one line
+func (a Answer) Lines() []string {
+	return strings.Split(a.Value, "\n")
+}
+
+func (a Answer) String() string {
+	return a.Value
+}
+
+// Parse picks the kind from the text: integers become Int or Big, anything
+// spanning several lines a Render and the rest a String
+func Parse(s string) Answer {
+	if strings.Contains(strings.Trim(s, "\n"), "\n") {
+		return Render(s)
+	}
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		if n.IsInt64() {
+			return Int64(n.Int64())
+		}
+		return Big(n)
+	}
+	return String(s)
+}
+
+// MarshalJSON writes the answer as a string, or null when there is no answer
+func (a Answer) MarshalJSON() ([]byte, error) {
+	if a.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(a.Value)
+}
+
+// UnmarshalJSON reads what MarshalJSON writes; null leaves the answer unknown
+func (a *Answer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*a = Answer{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*a = Parse(s)
+	return nil
+}
@@ -0,0 +1,75 @@
+package solutions
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestAnswerEqual(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		name  string
+		a, b  Answer
+		equal bool
+	}{
+		{"int and int", Int(42), Int(42), true},
+		{"int and big", Int(42), Big(big.NewInt(42)), true},
+		{"int and string", Int(42), String("42"), true},
+		{"big and string", Big(huge), String("123456789012345678901234567890"), true},
+		{"different ints", Int(42), Int(43), false},
+		{"negative", Int(-7), Big(big.NewInt(-7)), true},
+		{"string case", String("ABC"), String("abc"), false},
+		{"render and string", Render("#.\n.#\n"), String("#.\n.#"), true},
+		{"zero and zero", Answer{}, Answer{}, true},
+		{"zero and int", Answer{}, Int(0), false},
+		{"int and zero", Int(0), Answer{}, false},
+		{"zero and empty string", Answer{}, String(""), false},
+	}
+	for _, test := range tests {
+		if got := test.a.Equal(test.b); got != test.equal {
+			t.Errorf("%s: %q equal %q got %t", test.name, test.a, test.b, got)
+		}
+		if got := test.b.Equal(test.a); got != test.equal {
+			t.Errorf("%s: %q equal %q got %t the other way round", test.name, test.b, test.a, got)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text  string
+		kind  AnswerKind
+		value string
+	}{
+		{"1234", KindInt, "1234"},
+		{"-17", KindInt, "-17"},
+		{"9223372036854775808", KindBig, "9223372036854775808"},
+		{"CFLELOYFCS", KindString, "CFLELOYFCS"},
+		{"12a", KindString, "12a"},
+		{"\n#..#  \n####\n", KindRender, "#..#\n####"},
+	}
+	for _, test := range tests {
+		got := Parse(test.text)
+		if got.Kind != test.kind || got.Value != test.value {
+			t.Errorf("Parse(%q): got kind %d %q, expected kind %d %q", test.text, got.Kind, got.Value, test.kind, test.value)
+		}
+	}
+}
+
+func TestAnswerJSON(t *testing.T) {
+	var answers map[string]Answer
+	if err := json.Unmarshal([]byte(`{"part1": "26", "part2": null}`), &answers); err != nil {
+		t.Fatal(err)
+	}
+	if !answers["part1"].Equal(Int(26)) || !answers["part2"].IsZero() {
+		t.Errorf("got %v", answers)
+	}
+	data, err := json.Marshal(answers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := `{"part1":"26","part2":null}`; string(data) != expect {
+		t.Errorf("got %s, expected %s", data, expect)
+	}
+}
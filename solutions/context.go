@@ -0,0 +1,33 @@
+package solutions
+
+import "context"
+
+// Plain is a solution written before parts took a context
+type Plain interface {
+	Meta() Meta
+	Parse([]byte) error
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// WithContext lets a Plain solution be registered. Its parts cannot be
+// cancelled, so ctx is ignored and the runner has to abandon them instead.
+func WithContext(p Plain) Solution {
+	return &contextAdapter{Plain: p}
+}
+
+type contextAdapter struct {
+	Plain
+}
+
+func (c *contextAdapter) Part1(ctx context.Context) (Answer, error) {
+	return c.Plain.Part1()
+}
+
+func (c *contextAdapter) Part2(ctx context.Context) (Answer, error) {
+	return c.Plain.Part2()
+}
+
+func (c *contextAdapter) fresh() Solution {
+	return WithContext(zeroOf(c.Plain).(Plain))
+}
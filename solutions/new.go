@@ -0,0 +1,22 @@
+package solutions
+
+import "reflect"
+
+// New returns a fresh, zero-valued instance of the same type as s so repeated
+// runs do not share state left behind by an earlier Parse or an abandoned part
+func New(s Solution) Solution {
+	if w, ok := s.(interface{ fresh() Solution }); ok {
+		return w.fresh()
+	}
+	return zeroOf(s).(Solution)
+}
+
+// Registered solutions are pointers to structs; anything else is returned as
+// is since there is no way to know how to build another one
+func zeroOf(v any) any {
+	t := reflect.TypeOf(v)
+	if t.Kind() != reflect.Pointer {
+		return v
+	}
+	return reflect.New(t.Elem()).Interface()
+}
@@ -1,14 +1,39 @@
 package solutions
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
 
-var registered []Solution
+// entry keeps the year and problem of a registered solution, since Meta may
+// have to read every embedded input to answer
+type entry struct {
+	year     int
+	problem  int
+	solution Solution
+}
+
+var registered []entry
 
+// Data is one input for a solution. File is where the input was read from,
+// when that is known.
 type Data struct {
 	Name    string
+	File    string
 	Input   []byte
-	Expect1 int
-	Expect2 int
+	Expect1 Answer
+	Expect2 Answer
+}
+
+// Filename names the input in error messages. Embedded inputs without a File
+// go by the file their name implies: Test 2 -> test2.txt, Input -> input.txt
+func (d Data) Filename() string {
+	if d.File != "" {
+		return d.File
+	}
+	return strings.ToLower(strings.ReplaceAll(d.Name, " ", "")) + ".txt"
 }
 
 type Meta struct {
@@ -18,23 +43,43 @@ type Meta struct {
 	Datas   []Data
 }
 
+// Solution parts should give up and return ctx.Err() once ctx is done. The
+// runner abandons parts that ignore it, so they must not be reused afterwards.
 type Solution interface {
 	Meta() Meta
 	Parse([]byte) error
-	Part1() (int, error)
-	Part2() (int, error)
+	Part1(context.Context) (Answer, error)
+	Part2(context.Context) (Answer, error)
+}
+
+// All returns every registered solution ordered by year, then problem
+func All() []Solution {
+	sorted := make([]entry, len(registered))
+	copy(sorted, registered)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.year != b.year {
+			return a.year < b.year
+		}
+		return a.problem < b.problem
+	})
+	all := make([]Solution, len(sorted))
+	for i, e := range sorted {
+		all[i] = e.solution
+	}
+	return all
 }
 
 func Get(year int, problem int) (Solution, error) {
-	for _, s := range registered {
-		meta := s.Meta()
-		if meta.Year == year && meta.Problem == problem {
-			return s, nil
+	for _, e := range registered {
+		if e.year == year && e.problem == problem {
+			return e.solution, nil
 		}
 	}
 	return nil, fmt.Errorf("unable to find solution for year:%d problem: %d", year, problem)
 }
 
 func Register(s Solution) {
-	registered = append(registered, s)
+	meta := s.Meta()
+	registered = append(registered, entry{year: meta.Year, problem: meta.Problem, solution: s})
 }
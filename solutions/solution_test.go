@@ -0,0 +1,62 @@
+package solutions
+
+import (
+	"context"
+	"testing"
+)
+
+// counted is a solution that counts how often its Meta is read
+type counted struct {
+	year    int
+	problem int
+	metas   int
+}
+
+func (c *counted) Meta() Meta {
+	c.metas++
+	return Meta{Name: "counted", Year: c.year, Problem: c.problem}
+}
+
+func (c *counted) Parse([]byte) error {
+	return nil
+}
+
+func (c *counted) Part1(context.Context) (Answer, error) {
+	return Answer{}, nil
+}
+
+func (c *counted) Part2(context.Context) (Answer, error) {
+	return Answer{}, nil
+}
+
+func TestRegistry(t *testing.T) {
+	saved := registered
+	t.Cleanup(func() { registered = saved })
+	registered = nil
+
+	unsorted := []*counted{{year: 2001, problem: 2}, {year: 2000, problem: 9}, {year: 2001, problem: 1}}
+	for _, c := range unsorted {
+		Register(c)
+	}
+
+	all := All()
+	order := []*counted{unsorted[1], unsorted[2], unsorted[0]}
+	for i, c := range order {
+		if all[i] != c {
+			t.Errorf("%d: got %d/%02d, expected %d/%02d", i, all[i].(*counted).year, all[i].(*counted).problem, c.year, c.problem)
+		}
+	}
+
+	if s, err := Get(2001, 1); err != nil || s != unsorted[2] {
+		t.Errorf("got %v, %v, expected 2001/01", s, err)
+	}
+	if _, err := Get(2001, 3); err == nil {
+		t.Error("expected an error for a puzzle that is not registered")
+	}
+
+	for _, c := range unsorted {
+		if c.metas != 1 {
+			t.Errorf("%d/%02d: Meta read %d times, expected once when registered", c.year, c.problem, c.metas)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package solutionstest checks solutions against their expected answers from
+// go test
+package solutionstest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/internal/vault"
+	"github.com/jbaikge/advent-of-code/solutions"
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+// Check runs one subtest per dataset, each against a fresh instance, and
+// compares both parts with Expect1 and Expect2 where they are known. With
+// -short only the example datasets, those named Test..., are run. Locked
+// inputs are skipped.
+func Check(t *testing.T, s solutions.Solution, datas []solutions.Data) {
+	t.Helper()
+	for _, data := range datas {
+		data := data
+		t.Run(data.Name, func(t *testing.T) {
+			if testing.Short() && !IsExample(data) {
+				t.Skip("skipping puzzle input in short mode")
+			}
+
+			input, err := vault.Open(data.Input)
+			if errors.Is(err, vault.ErrLocked) {
+				t.Skipf("%v, set %s to run it", err, vault.KeyEnv)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fresh := solutions.New(s)
+			if err := fresh.Parse(input); err != nil {
+				t.Fatalf("parse: %v", util.InFile(err, data.Filename()))
+			}
+			check(t, 1, fresh.Part1, data.Expect1)
+			check(t, 2, fresh.Part2, data.Expect2)
+		})
+	}
+}
+
+// fuzzDeadline bounds the parts run on each fuzzed input. Parts that cannot be
+// cancelled carry on past it.
+const fuzzDeadline = 100 * time.Millisecond
+
+// IsExample reports whether the dataset is one of the puzzle's examples
+// rather than a real input
+func IsExample(data solutions.Data) bool {
+	return strings.HasPrefix(data.Name, "Test")
+}
+
+func check(t *testing.T, n int, part func(context.Context) (solutions.Answer, error), expect solutions.Answer) {
+	t.Helper()
+	answer, err := part(context.Background())
+	switch {
+	case err != nil:
+		t.Errorf("part %d: %v", n, err)
+	case expect.IsZero():
+		t.Logf("part %d: %s, no expected answer", n, answer)
+	case !answer.Equal(expect):
+		t.Errorf("part %d: got %s, expected %s", n, answer, expect)
+	}
+}
+
+// FuzzParse seeds the corpus with the example datasets of the registered
+// solution and checks that Parse returns an error, rather than panicking, on
+// whatever input it is given. Input that parses is also run through both parts
+// under a short deadline, which must not panic either.
+func FuzzParse(f *testing.F, year int, problem int) {
+	s, err := solutions.Get(year, problem)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, data := range s.Meta().Datas {
+		if IsExample(data) {
+			f.Add(data.Input)
+		}
+	}
+	f.Fuzz(func(t *testing.T, input []byte) {
+		fresh := solutions.New(s)
+		if err := fresh.Parse(input); err != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), fuzzDeadline)
+		defer cancel()
+		fresh.Part1(ctx)
+		fresh.Part2(ctx)
+	})
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/internal/aoc"
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+// inputDataset is the dataset holding the user's own puzzle input
+const inputDataset = "Input"
+
+func submitMain(args []string) {
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+	configPath := flags.String("config", "", "read the session and base URL from `file` instead of the user config directory")
+	baseURL := flags.String("base-url", "", "submit to `url` instead of the configured server")
+	cacheDir := flags.String("cache", "", "remember guesses in `dir` instead of the user cache directory")
+	answers := flags.String("answers", "answers.json", "record correct answers in `file`")
+	partTimeout := flags.Duration("timeout", time.Minute, "give up on the part after `duration`; 0 waits forever")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s submit [flags] YEAR DAY PART\n", filepath.Base(os.Args[0]))
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 3 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	year, day, err := parseDay(flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	part, err := strconv.Atoi(flags.Arg(2))
+	if err != nil || part < 1 || part > 2 {
+		fmt.Fprintf(os.Stderr, "invalid part: %s\n", flags.Arg(2))
+		os.Exit(2)
+	}
+
+	cache := aoc.Cache{Dir: *cacheDir}
+	if *cacheDir == "" {
+		if cache, err = aoc.DefaultCache(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	s := Submission{
+		Year:    year,
+		Day:     day,
+		Part:    part,
+		Cache:   cache,
+		Answers: *answers,
+		Timeout: *partTimeout,
+	}
+	if err := s.Submit(*configPath, *baseURL); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// Submission solves one part against the user's input and posts the answer
+type Submission struct {
+	Year    int
+	Day     int
+	Part    int
+	Cache   aoc.Cache
+	Answers string
+	Timeout time.Duration
+}
+
+func (s Submission) Submit(configPath string, baseURL string) (err error) {
+	manifest, err := LoadManifest(s.Answers)
+	if err != nil {
+		return
+	}
+	answer, known, err := s.solve(manifest)
+	if err != nil {
+		return
+	}
+	if !known.IsZero() {
+		if known.Equal(answer) {
+			fmt.Printf("%d/%02d part %d: %s is already known to be correct\n", s.Year, s.Day, s.Part, answer)
+			return
+		}
+		return fmt.Errorf("%d/%02d part %d: answer %s does not match the recorded %s, not submitting", s.Year, s.Day, s.Part, answer, known)
+	}
+
+	guesses, err := s.Cache.Guesses(s.Year, s.Day)
+	if err != nil {
+		return
+	}
+	if reason := ruledOut(guesses, s.Part, answer.String()); reason != "" {
+		return fmt.Errorf("%d/%02d part %d: not submitting %s, %s", s.Year, s.Day, s.Part, answer, reason)
+	}
+
+	until, err := s.Cache.WaitUntil()
+	if err != nil {
+		return
+	}
+	if wait := time.Until(until); wait > 0 {
+		return fmt.Errorf("rate limited, try again in %s", wait.Round(time.Second))
+	}
+
+	config, err := loadConfig(configPath, baseURL)
+	if err != nil {
+		return
+	}
+	client, err := aoc.NewClient(config)
+	if err != nil {
+		return
+	}
+	outcome, err := client.Submit(context.Background(), s.Year, s.Day, s.Part, answer.String())
+	if err != nil {
+		return
+	}
+
+	if outcome.Wait > 0 {
+		if err = s.Cache.SetWaitUntil(time.Now().Add(outcome.Wait)); err != nil {
+			return
+		}
+	}
+
+	fmt.Printf("%d/%02d part %d: %s is %s\n", s.Year, s.Day, s.Part, answer, outcome.Verdict)
+	switch outcome.Verdict {
+	case aoc.Correct:
+		manifest.Set(s.Year, s.Day, inputDataset, s.Part, answer)
+		return manifest.Save(s.Answers)
+	case aoc.TooHigh, aoc.TooLow, aoc.Wrong:
+		guess := aoc.Guess{Part: s.Part, Answer: answer.String(), Verdict: outcome.Verdict}
+		if err = s.Cache.AddGuess(s.Year, s.Day, guess); err != nil {
+			return
+		}
+	}
+	return fmt.Errorf("%s", outcome.Message)
+}
+
+// solve runs the registered solution against its Input dataset and returns
+// the answer along with what it is expected to be, if known
+func (s Submission) solve(manifest Manifest) (answer solutions.Answer, expect solutions.Answer, err error) {
+	solution, err := solutions.Get(s.Year, s.Day)
+	if err != nil {
+		return
+	}
+
+	var input *solutions.Data
+	datas := manifest.Datas(solution.Meta())
+	for i := range datas {
+		if datas[i].Name == inputDataset {
+			input = &datas[i]
+		}
+	}
+	if input == nil || len(input.Input) == 0 {
+		err = fmt.Errorf("%d/%02d has no input, fetch it first", s.Year, s.Day)
+		return
+	}
+
+	r := RunPart(solution, *input, s.Part, s.Timeout)
+	answer, expect, err = r.Answer1, r.Expect1, r.Part1Err
+	if s.Part == 2 {
+		answer, expect, err = r.Answer2, r.Expect2, r.Part2Err
+	}
+	switch {
+	case r.ParseErr != nil:
+		err = fmt.Errorf("unable to parse input: %w", r.ParseErr)
+	case err != nil:
+		err = fmt.Errorf("part %d failed: %w", s.Part, err)
+	case answer.IsZero():
+		err = fmt.Errorf("part %d has no answer", s.Part)
+	case answer.Kind == solutions.KindRender:
+		err = fmt.Errorf("part %d is drawn, read it and submit by hand:\n%s", s.Part, answer)
+	}
+	return
+}
+
+// ruledOut explains why an earlier wrong guess means answer cannot be right,
+// or returns an empty string
+func ruledOut(guesses []aoc.Guess, part int, answer string) string {
+	n, numeric := new(big.Int).SetString(answer, 10)
+	for _, g := range guesses {
+		if g.Part != part {
+			continue
+		}
+		if g.Answer == answer {
+			return "it was already guessed and is " + string(g.Verdict)
+		}
+		guess, ok := new(big.Int).SetString(g.Answer, 10)
+		if !numeric || !ok {
+			continue
+		}
+		if g.Verdict == aoc.TooHigh && n.Cmp(guess) >= 0 {
+			return fmt.Sprintf("%s was already too high", g.Answer)
+		}
+		if g.Verdict == aoc.TooLow && n.Cmp(guess) <= 0 {
+			return fmt.Sprintf("%s was already too low", g.Answer)
+		}
+	}
+	return ""
+}
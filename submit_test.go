@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/internal/aoc"
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+// stubPuzzle stands in for a registered puzzle so submit can be tested
+// without a real input, which may be sealed
+type stubPuzzle struct{}
+
+func (*stubPuzzle) Meta() solutions.Meta {
+	return solutions.Meta{
+		Name:    "stub",
+		Year:    2000,
+		Problem: 13,
+		Datas:   []solutions.Data{{Name: inputDataset, Input: []byte("stub\n")}},
+	}
+}
+
+func (*stubPuzzle) Parse([]byte) error {
+	return nil
+}
+
+func (*stubPuzzle) Part1(context.Context) (solutions.Answer, error) {
+	return solutions.Int(5390), nil
+}
+
+func (*stubPuzzle) Part2(context.Context) (solutions.Answer, error) {
+	return solutions.Int(19261), nil
+}
+
+func TestRuledOut(t *testing.T) {
+	guesses := []aoc.Guess{
+		{Part: 1, Answer: "100", Verdict: aoc.TooHigh},
+		{Part: 1, Answer: "40", Verdict: aoc.TooLow},
+		{Part: 1, Answer: "77", Verdict: aoc.Wrong},
+		{Part: 1, Answer: "ABC", Verdict: aoc.Wrong},
+		{Part: 2, Answer: "5", Verdict: aoc.TooHigh},
+	}
+	tests := []struct {
+		part   int
+		answer string
+		reason string
+	}{
+		{1, "100", "it was already guessed and is too high"},
+		{1, "40", "it was already guessed and is too low"},
+		{1, "77", "it was already guessed and is wrong"},
+		{1, "ABC", "it was already guessed and is wrong"},
+		{1, "150", "100 was already too high"},
+		{1, "123456789012345678901234567890", "100 was already too high"},
+		{1, "12", "40 was already too low"},
+		{1, "-5", "40 was already too low"},
+		{1, "41", ""},
+		{1, "78", ""},
+		{1, "99", ""},
+		{1, "abc", ""},
+		{2, "100", "5 was already too high"},
+		{2, "40", "5 was already too high"},
+		{2, "4", ""},
+		{3, "100", ""},
+	}
+	for _, test := range tests {
+		if got := ruledOut(guesses, test.part, test.answer); got != test.reason {
+			t.Errorf("part %d %s: got %q, expected %q", test.part, test.answer, got, test.reason)
+		}
+	}
+}
+
+// TestSubmitGuesses submits the stub's answer against a server that turns it
+// down, then checks that the guess narrows what may be submitted
+// and that the server's wait is respected
+func TestSubmitGuesses(t *testing.T) {
+	if _, err := solutions.Get(2000, 13); err != nil {
+		solutions.Register(new(stubPuzzle))
+	}
+
+	verdicts := map[string]string{
+		"1": `<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article>`,
+		"2": `<article><p>That's the right answer!  You are <em>one gold star</em> closer to saving your vacation.</p></article>`,
+	}
+	var posts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2000/day/13/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		posts++
+		w.Write([]byte(verdicts[r.FormValue("level")]))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	t.Setenv(aoc.SessionEnv, "secret")
+	s := Submission{
+		Year:    2000,
+		Day:     13,
+		Part:    1,
+		Cache:   aoc.Cache{Dir: filepath.Join(dir, "cache")},
+		Answers: filepath.Join(dir, "answers.json"),
+	}
+	submit := func() error {
+		return s.Submit(filepath.Join(dir, "config.json"), server.URL+"/")
+	}
+
+	if err := submit(); err == nil || !strings.Contains(err.Error(), "too high") {
+		t.Fatalf("got %v, expected the server's too high", err)
+	}
+	guesses, err := s.Cache.Guesses(s.Year, s.Day)
+	if err != nil || len(guesses) != 1 || guesses[0].Verdict != aoc.TooHigh {
+		t.Fatalf("got %v, %v, expected one guess that is too high", guesses, err)
+	}
+	answer := guesses[0].Answer
+	if reason := ruledOut(guesses, 1, answer+"0"); reason != answer+" was already too high" {
+		t.Errorf("a bigger answer got %q", reason)
+	}
+	if reason := ruledOut(guesses, 1, answer[:len(answer)-1]); reason != "" {
+		t.Errorf("a smaller answer got %q", reason)
+	}
+
+	// The same answer again is refused without asking the server
+	if err := submit(); err == nil || !strings.Contains(err.Error(), "already guessed") {
+		t.Errorf("got %v, expected the duplicate guess to be refused", err)
+	}
+
+	// Part 2 has no guesses yet but has to wait out the minute
+	s.Part = 2
+	if err := submit(); err == nil || !strings.Contains(err.Error(), "rate limited") {
+		t.Errorf("got %v, expected to be rate limited", err)
+	}
+	if posts != 1 {
+		t.Errorf("server got %d submissions, expected 1", posts)
+	}
+
+	if err := s.Cache.SetWaitUntil(time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := submit(); err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := LoadManifest(s.Answers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := manifest[manifestKey(2000, 13)][inputDataset]; expected[part2Key].IsZero() {
+		t.Errorf("got %v, expected the correct part 2 to be recorded", expected)
+	}
+}
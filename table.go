@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jbaikge/advent-of-code/internal/vault"
+	"github.com/jbaikge/advent-of-code/solutions"
+)
+
+// PrintTable writes one row per dataset. Rendered answers do not fit in a
+// cell, so they are printed after the table.
+func PrintTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Year\tDay\tName\tDataset\tPart 1\t\tPart 2\t\tParse\tPart 1\tPart 2")
+
+	type render struct {
+		Title  string
+		Answer solutions.Answer
+	}
+	renders := make([]render, 0)
+
+	for _, r := range results {
+		answers := [2]solutions.Answer{r.Answer1, r.Answer2}
+		cells := [2]string{}
+		for i, answer := range answers {
+			cells[i] = answer.String()
+			if answer.Kind == solutions.KindRender {
+				cells[i] = "(render)"
+				renders = append(renders, render{
+					Title:  fmt.Sprintf("%d/%02d %s %s part %d", r.Year, r.Problem, r.Name, r.Dataset, i+1),
+					Answer: answer,
+				})
+			}
+		}
+
+		fmt.Fprintf(
+			tw,
+			"%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.Year,
+			r.Problem,
+			r.Name,
+			r.Dataset,
+			cells[0],
+			statusLabel(r.Status1()),
+			cells[1],
+			statusLabel(r.Status2()),
+			round(r.Parse),
+			round(r.Part1),
+			round(r.Part2),
+		)
+	}
+	tw.Flush()
+
+	for _, r := range renders {
+		fmt.Fprintf(w, "\n%s:\n", r.Title)
+		for _, line := range r.Answer.Lines() {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+
+	for _, r := range results {
+		if r.Locked() {
+			continue
+		}
+		for p, err := range r.Errs() {
+			if err == nil {
+				continue
+			}
+			fmt.Fprintf(w, "\n%d/%02d %s %s %s: %v\n", r.Year, r.Problem, r.Name, r.Dataset, phaseNames[p], err)
+			if stack := stackOf(err); stack != "" {
+				for _, line := range strings.Split(strings.TrimRight(stack, "\n"), "\n") {
+					fmt.Fprintf(w, "  %s\n", line)
+				}
+			}
+		}
+	}
+
+	// A dataset that fails to parse counts once, not once for each part that
+	// never ran
+	passed, failed, timedOut, errored, unparsed, locked := 0, 0, 0, 0, 0, 0
+	for _, r := range results {
+		if r.Locked() {
+			locked++
+			continue
+		}
+		if r.ParseErr != nil {
+			unparsed++
+			continue
+		}
+		for _, s := range []string{r.Status1(), r.Status2()} {
+			switch s {
+			case StatusPass:
+				passed++
+			case StatusFail:
+				failed++
+			case StatusTimeout:
+				timedOut++
+			case StatusError:
+				errored++
+			}
+		}
+	}
+	summary := fmt.Sprintf("%d datasets: %d parts passed, %d failed", len(results), passed, failed)
+	if timedOut > 0 {
+		summary += fmt.Sprintf(", %d timed out", timedOut)
+	}
+	if errored > 0 {
+		summary += fmt.Sprintf(", %d errored", errored)
+	}
+	if unparsed > 0 {
+		summary += fmt.Sprintf("; %d datasets failed to parse", unparsed)
+	}
+	if locked > 0 {
+		summary += fmt.Sprintf("; %d inputs locked, set %s to run them", locked, vault.KeyEnv)
+	}
+	_, err := fmt.Fprintf(w, "\n%s\n", summary)
+	return err
+}
+
+func statusLabel(status string) string {
+	if status == StatusUnknown {
+		return ""
+	}
+	return strings.ToUpper(status)
+}
+
+// Keep the timing columns readable; sub-microsecond precision is noise
+func round(d time.Duration) time.Duration {
+	switch {
+	case d > time.Second:
+		return d.Round(time.Millisecond)
+	case d > time.Millisecond:
+		return d.Round(time.Microsecond)
+	}
+	return d
+}
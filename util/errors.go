@@ -0,0 +1,112 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError locates a problem in a puzzle input. Line and Col count from 1;
+// a zero Col blames the whole line and a zero Line the whole input. File is
+// usually left for the runner to fill in with InFile.
+type ParseError struct {
+	File string
+	Line int
+	Col  int
+	Text string
+	Msg  string
+	Err  error
+}
+
+// Error reads like a compiler's: input.txt:17:23: expected integer, got 'x'
+func (e *ParseError) Error() string {
+	msg := e.Msg
+	if msg == "" && e.Err != nil {
+		msg = e.Err.Error()
+	}
+	if e.Text != "" {
+		msg += fmt.Sprintf(", got '%s'", e.Text)
+	}
+
+	pos := e.File
+	if e.Line > 0 {
+		pos += ":" + strconv.Itoa(e.Line)
+		if e.Col > 0 {
+			pos += ":" + strconv.Itoa(e.Col)
+		}
+	}
+	pos = strings.TrimPrefix(pos, ":")
+	if pos == "" {
+		return msg
+	}
+	return pos + ": " + msg
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Errorf reports text found at line and col
+func Errorf(line int, col int, text string, format string, args ...any) error {
+	return &ParseError{
+		Line: line,
+		Col:  col,
+		Text: text,
+		Msg:  fmt.Sprintf(format, args...),
+	}
+}
+
+// Atoi converts text found at line and col, reporting where it was when it is
+// not an integer
+func Atoi(line int, col int, text string) (n int, err error) {
+	n, err = strconv.Atoi(text)
+	if err != nil {
+		msg := "expected integer"
+		if errors.Is(err, strconv.ErrRange) {
+			msg = "integer out of range"
+		}
+		err = &ParseError{Line: line, Col: col, Text: text, Msg: msg, Err: err}
+	}
+	return
+}
+
+// AtLine places err on line. Parsers that work a line at a time report
+// columns and leave the caller, which counts lines, to fill in the rest.
+func AtLine(err error, line int) error {
+	return At(err, line, 1)
+}
+
+// At places err, found in a piece of text, where that text starts in the
+// input: on line, with its columns counted from col
+func At(err error, line int, col int) error {
+	if err == nil {
+		return nil
+	}
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		return &ParseError{Line: line, Err: err}
+	}
+	if parseErr.Line == 0 {
+		parseErr.Line = line
+		if parseErr.Col > 0 {
+			parseErr.Col += col - 1
+		}
+	}
+	return err
+}
+
+// InFile names the file a parse error came from
+func InFile(err error, file string) error {
+	if err == nil {
+		return nil
+	}
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		return &ParseError{File: file, Err: err}
+	}
+	if parseErr.File == "" {
+		parseErr.File = file
+	}
+	return err
+}
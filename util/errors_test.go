@@ -0,0 +1,85 @@
+package util
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestParseErrorString(t *testing.T) {
+	tests := []struct {
+		err    *ParseError
+		expect string
+	}{
+		{&ParseError{File: "input.txt", Line: 17, Col: 23, Text: "x", Msg: "expected integer"}, "input.txt:17:23: expected integer, got 'x'"},
+		{&ParseError{File: "input.txt", Line: 3, Msg: "expected a-b,c-d"}, "input.txt:3: expected a-b,c-d"},
+		{&ParseError{File: "input.txt", Msg: "no sensors"}, "input.txt: no sensors"},
+		{&ParseError{Line: 2, Col: 5, Msg: "unknown card"}, "2:5: unknown card"},
+		{&ParseError{Err: errors.New("no seeds")}, "no seeds"},
+	}
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.expect {
+			t.Errorf("got %q, expected %q", got, test.expect)
+		}
+	}
+}
+
+func TestAtoi(t *testing.T) {
+	n, err := Atoi(4, 7, "-12")
+	if err != nil || n != -12 {
+		t.Fatalf("got %d, %v", n, err)
+	}
+
+	_, err = Atoi(4, 7, "1x")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 4 || parseErr.Col != 7 || parseErr.Text != "1x" {
+		t.Fatalf("got %#v", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected %v to wrap strconv.ErrSyntax", err)
+	}
+}
+
+func TestAt(t *testing.T) {
+	// An error at column 3 of a piece of text that starts at column 10
+	err := At(Errorf(0, 3, "?", "expected + or *"), 5, 10)
+	if got, expect := InFile(err, "input.txt").Error(), "input.txt:5:12: expected + or *, got '?'"; got != expect {
+		t.Errorf("got %q, expected %q", got, expect)
+	}
+
+	// Positions already filled in are left alone
+	err = AtLine(InFile(Errorf(2, 1, "", "first"), "test.txt"), 9)
+	if got, expect := InFile(err, "input.txt").Error(), "test.txt:2:1: first"; got != expect {
+		t.Errorf("got %q, expected %q", got, expect)
+	}
+
+	// Plain errors are wrapped
+	plain := errors.New("no folds found")
+	err = AtLine(plain, 3)
+	if !errors.Is(err, plain) || err.Error() != "3: no folds found" {
+		t.Errorf("got %q", err)
+	}
+	if At(nil, 1, 1) != nil || InFile(nil, "input.txt") != nil {
+		t.Error("expected nil errors to stay nil")
+	}
+}
+
+func TestFields(t *testing.T) {
+	fields := FieldsFunc("Valve AA has flow rate=0; tunnels", func(r rune) bool {
+		return r == ' ' || r == '=' || r == ';'
+	})
+	expect := []Field{{"Valve", 1}, {"AA", 7}, {"has", 10}, {"flow", 14}, {"rate", 19}, {"0", 24}, {"tunnels", 27}}
+	if len(fields) != len(expect) {
+		t.Fatalf("got %v, expected %v", fields, expect)
+	}
+	for i := range fields {
+		if fields[i] != expect[i] {
+			t.Errorf("field %d: got %v, expected %v", i, fields[i], expect[i])
+		}
+	}
+
+	split := Split("498,4 -> 498,6", " -> ")
+	if len(split) != 2 || split[1] != (Field{"498,6", 10}) {
+		t.Errorf("got %v", split)
+	}
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Field is a piece of a line along with the column it starts at, so errors
+// about it can point at it
+type Field struct {
+	Text string
+	Col  int
+}
+
+// Fields splits line around runs of white space, as strings.Fields does
+func Fields(line string) []Field {
+	return FieldsFunc(line, unicode.IsSpace)
+}
+
+// FieldsFunc splits line around runs of runes satisfying f, as
+// strings.FieldsFunc does
+func FieldsFunc(line string, f func(rune) bool) (fields []Field) {
+	start := -1
+	for i, r := range line {
+		switch {
+		case f(r) && start >= 0:
+			fields = append(fields, Field{Text: line[start:i], Col: start + 1})
+			start = -1
+		case !f(r) && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, Field{Text: line[start:], Col: start + 1})
+	}
+	return
+}
+
+// Split slices line around each sep, as strings.Split does
+func Split(line string, sep string) (fields []Field) {
+	col := 1
+	for _, text := range strings.Split(line, sep) {
+		fields = append(fields, Field{Text: text, Col: col})
+		col += len(text) + len(sep)
+	}
+	return
+}
+
+// Texts drops the columns
+func Texts(fields []Field) []string {
+	texts := make([]string, len(fields))
+	for i, field := range fields {
+		texts[i] = field.Text
+	}
+	return texts
+}
@@ -0,0 +1,78 @@
+package geom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	a, b := Point{X: 8, Y: 7}, Point{X: 2, Y: 10}
+	if got := a.Manhattan(b); got != 9 {
+		t.Errorf("manhattan: got %d, expected 9", got)
+	}
+	if got := a.Chebyshev(b); got != 6 {
+		t.Errorf("chebyshev: got %d, expected 6", got)
+	}
+
+	c, d := Point3{X: 1, Y: -2, Z: 3}, Point3{X: -1, Y: 2, Z: 8}
+	if got := c.Manhattan(d); got != 11 {
+		t.Errorf("manhattan 3d: got %d, expected 11", got)
+	}
+	if got := c.Chebyshev(d); got != 5 {
+		t.Errorf("chebyshev 3d: got %d, expected 5", got)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	p := Point{X: 3, Y: -4}
+	if got := p.Add(Down).Sub(Left).Scale(2); got != (Point{X: 8, Y: -6}) {
+		t.Errorf("got %s", got)
+	}
+	if got := p.Sign(); got != (Point{X: 1, Y: -1}) {
+		t.Errorf("sign: got %s", got)
+	}
+	if got := p.Clamp(-2, 2); got != (Point{X: 2, Y: -2}) {
+		t.Errorf("clamp: got %s", got)
+	}
+	if got := (Point3{X: 0, Y: -7, Z: 2}).Sign(); got != (Point3{X: 0, Y: -1, Z: 1}) {
+		t.Errorf("sign 3d: got %s", got)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	for i, step := range Orthogonal {
+		next := Orthogonal[(i+1)%len(Orthogonal)]
+		if got := step.RotateRight(); got != next {
+			t.Errorf("%s rotated right: got %s, expected %s", step, got, next)
+		}
+		if got := next.RotateLeft(); got != step {
+			t.Errorf("%s rotated left: got %s, expected %s", next, got, step)
+		}
+	}
+	p := Point{X: 5, Y: 2}
+	if got := p.RotateRight().RotateRight(); got != p.Scale(-1) {
+		t.Errorf("half turn: got %s", got)
+	}
+}
+
+func TestLine(t *testing.T) {
+	tests := []struct {
+		a, b   Point
+		expect []Point
+	}{
+		{Point{X: 1, Y: 1}, Point{X: 1, Y: 3}, []Point{{1, 1}, {1, 2}, {1, 3}}},
+		{Point{X: 9, Y: 7}, Point{X: 7, Y: 7}, []Point{{9, 7}, {8, 7}, {7, 7}}},
+		{Point{X: 9, Y: 7}, Point{X: 7, Y: 9}, []Point{{9, 7}, {8, 8}, {7, 9}}},
+		{Point{X: 0, Y: 0}, Point{X: 3, Y: 1}, []Point{{0, 0}, {1, 0}, {2, 1}, {3, 1}}},
+		{Point{X: 2, Y: 2}, Point{X: 2, Y: 2}, []Point{{2, 2}}},
+	}
+	for _, test := range tests {
+		if got := Line(test.a, test.b); !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("%s to %s: got %v, expected %v", test.a, test.b, got, test.expect)
+		}
+		back := Line(test.b, test.a)
+		if len(back) != len(test.expect) || back[0] != test.b || back[len(back)-1] != test.a {
+			t.Errorf("%s to %s: got %v going backwards", test.b, test.a, back)
+		}
+	}
+}
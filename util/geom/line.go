@@ -0,0 +1,29 @@
+package geom
+
+// Line lists the points from a to b, both ends included, using Bresenham's
+// algorithm. Rows, columns and 45 degree diagonals come out exact; other
+// slopes step to whichever point lies closest to the true line.
+// Ref: https://en.wikipedia.org/wiki/Bresenham%27s_line_algorithm
+func Line(a, b Point) []Point {
+	d := b.Sub(a)
+	dx, dy := Abs(d.X), -Abs(d.Y)
+	step := d.Sign()
+
+	points := make([]Point, 0, a.Chebyshev(b)+1)
+	p, err := a, dx+dy
+	for {
+		points = append(points, p)
+		if p == b {
+			return points
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			p.X += step.X
+		}
+		if e2 <= dx {
+			err += dx
+			p.Y += step.Y
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Package geom holds integer points on a plane and in space. A point doubles
+// as the step between two points, so Sub gives the vector from one to the
+// other and Add moves along it.
+//
+// The plane is drawn the way puzzle input reads: X counts columns to the
+// right and Y counts rows down, so Up is a step of -1 in Y.
+package geom
+
+import "fmt"
+
+type Point struct {
+	X int
+	Y int
+}
+
+// Steps to a neighbouring point
+var (
+	Origin    = Point{X: 0, Y: 0}
+	Up        = Point{X: 0, Y: -1}
+	Down      = Point{X: 0, Y: 1}
+	Left      = Point{X: -1, Y: 0}
+	Right     = Point{X: 1, Y: 0}
+	UpLeft    = Point{X: -1, Y: -1}
+	UpRight   = Point{X: 1, Y: -1}
+	DownLeft  = Point{X: -1, Y: 1}
+	DownRight = Point{X: 1, Y: 1}
+
+	// Orthogonal are the four steps that share an edge, clockwise from Up
+	Orthogonal = []Point{Up, Right, Down, Left}
+	// Surrounding are all eight steps, corners included, clockwise from Up
+	Surrounding = []Point{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+)
+
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+func (p Point) Sub(q Point) Point {
+	return Point{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+func (p Point) Scale(n int) Point {
+	return Point{X: p.X * n, Y: p.Y * n}
+}
+
+// Manhattan is the distance to q moving only along rows and columns
+func (p Point) Manhattan(q Point) int {
+	d := p.Sub(q)
+	return Abs(d.X) + Abs(d.Y)
+}
+
+// Chebyshev is the distance to q when diagonal moves count as one step, the
+// way a king moves
+func (p Point) Chebyshev(q Point) int {
+	d := p.Sub(q)
+	return maxInt(Abs(d.X), Abs(d.Y))
+}
+
+// Sign shrinks each coordinate to -1, 0 or 1, turning a vector into a single
+// step in the same general direction
+func (p Point) Sign() Point {
+	return Point{X: Sign(p.X), Y: Sign(p.Y)}
+}
+
+// Clamp limits each coordinate to the range lo to hi
+func (p Point) Clamp(lo, hi int) Point {
+	return Point{X: Clamp(p.X, lo, hi), Y: Clamp(p.Y, lo, hi)}
+}
+
+// RotateRight turns the vector 90 degrees clockwise, so Up becomes Right
+func (p Point) RotateRight() Point {
+	return Point{X: -p.Y, Y: p.X}
+}
+
+// RotateLeft turns the vector 90 degrees anticlockwise, so Up becomes Left
+func (p Point) RotateLeft() Point {
+	return Point{X: p.Y, Y: -p.X}
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
+}
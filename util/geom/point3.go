@@ -0,0 +1,50 @@
+package geom
+
+import "fmt"
+
+type Point3 struct {
+	X int
+	Y int
+	Z int
+}
+
+// Faces are the six steps to a cube sharing a face
+var Faces = []Point3{
+	{X: 1}, {X: -1},
+	{Y: 1}, {Y: -1},
+	{Z: 1}, {Z: -1},
+}
+
+func (p Point3) Add(q Point3) Point3 {
+	return Point3{X: p.X + q.X, Y: p.Y + q.Y, Z: p.Z + q.Z}
+}
+
+func (p Point3) Sub(q Point3) Point3 {
+	return Point3{X: p.X - q.X, Y: p.Y - q.Y, Z: p.Z - q.Z}
+}
+
+func (p Point3) Scale(n int) Point3 {
+	return Point3{X: p.X * n, Y: p.Y * n, Z: p.Z * n}
+}
+
+func (p Point3) Manhattan(q Point3) int {
+	d := p.Sub(q)
+	return Abs(d.X) + Abs(d.Y) + Abs(d.Z)
+}
+
+func (p Point3) Chebyshev(q Point3) int {
+	d := p.Sub(q)
+	return maxInt(Abs(d.X), maxInt(Abs(d.Y), Abs(d.Z)))
+}
+
+func (p Point3) Sign() Point3 {
+	return Point3{X: Sign(p.X), Y: Sign(p.Y), Z: Sign(p.Z)}
+}
+
+func (p Point3) Clamp(lo, hi int) Point3 {
+	return Point3{X: Clamp(p.X, lo, hi), Y: Clamp(p.Y, lo, hi), Z: Clamp(p.Z, lo, hi)}
+}
+
+func (p Point3) String() string {
+	return fmt.Sprintf("(%d, %d, %d)", p.X, p.Y, p.Z)
+}
@@ -0,0 +1,44 @@
+package grid
+
+// Fill floods out from start through neighbours sharing an edge, taking in
+// every point whose cell satisfies in. Nothing is filled when start itself
+// does not.
+func (g *Grid[T]) Fill(start Point, in func(v T) bool) (region []Point) {
+	return g.fill(start, in, make([]bool, len(g.Cells)))
+}
+
+// Regions splits the cells satisfying in into separate areas, each as Fill
+// would find it, ordered by the first point of each a row at a time
+func (g *Grid[T]) Regions(in func(v T) bool) (regions [][]Point) {
+	seen := make([]bool, len(g.Cells))
+	for _, p := range g.Points() {
+		if region := g.fill(p, in, seen); len(region) > 0 {
+			regions = append(regions, region)
+		}
+	}
+	return
+}
+
+func (g *Grid[T]) fill(start Point, in func(v T) bool, seen []bool) (region []Point) {
+	if !g.In(start) || seen[g.index(start)] || !in(g.At(start)) {
+		return
+	}
+	seen[g.index(start)] = true
+	queue := []Point{start}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		region = append(region, p)
+		for _, q := range g.Neighbours4(p) {
+			if i := g.index(q); !seen[i] && in(g.Cells[i]) {
+				seen[i] = true
+				queue = append(queue, q)
+			}
+		}
+	}
+	return
+}
+
+func (g *Grid[T]) index(p Point) int {
+	return p.Y*g.Width + p.X
+}
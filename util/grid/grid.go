@@ -0,0 +1,207 @@
+// Package grid holds the two-dimensional maps most puzzles are drawn on. X
+// counts columns to the right and Y counts rows down from the top, the way
+// the input reads.
+package grid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/util/geom"
+	"github.com/jbaikge/advent-of-code/util/parse"
+)
+
+// Point is shared with geom so the two packages mix freely
+type Point = geom.Point
+
+// Grid is a dense rectangle of cells, stored a row at a time
+type Grid[T any] struct {
+	Width  int
+	Height int
+	Cells  []T
+}
+
+func New[T any](width int, height int) *Grid[T] {
+	return &Grid[T]{
+		Width:  width,
+		Height: height,
+		Cells:  make([]T, width*height),
+	}
+}
+
+// FromRows copies rows of equal length into a grid
+func FromRows[T any](rows [][]T) *Grid[T] {
+	g := new(Grid[T])
+	if len(rows) == 0 {
+		return g
+	}
+	g.Width, g.Height = len(rows[0]), len(rows)
+	g.Cells = make([]T, 0, g.Width*g.Height)
+	for _, row := range rows {
+		g.Cells = append(g.Cells, row[:g.Width]...)
+	}
+	return g
+}
+
+// Parse reads a rectangle of characters, one row per line
+func Parse(data []byte) (g *Grid[byte], err error) {
+	return parseRows(data, func(line parse.Line, x int) (byte, error) {
+		return line.Text[x], nil
+	})
+}
+
+// ParseDigits reads a rectangle of single digits, one row per line
+func ParseDigits(data []byte) (g *Grid[int], err error) {
+	return parseRows(data, func(line parse.Line, x int) (int, error) {
+		if b := line.Text[x]; '0' <= b && b <= '9' {
+			return int(b - '0'), nil
+		}
+		return 0, line.Errorf(x+1, line.Text[x:x+1], "expected digit")
+	})
+}
+
+// parseRows reads lines of equal length, turning each character into a cell
+func parseRows[T any](data []byte, cell func(line parse.Line, x int) (T, error)) (g *Grid[T], err error) {
+	lines := parse.Lines(data)
+	g = new(Grid[T])
+	if len(lines) == 0 {
+		return
+	}
+	g.Width, g.Height = len(lines[0].Text), len(lines)
+	g.Cells = make([]T, 0, g.Width*g.Height)
+	for _, line := range lines {
+		if len(line.Text) != g.Width {
+			return nil, line.Errorf(0, line.Text, "expected %d columns", g.Width)
+		}
+		for x := range line.Text {
+			v, err := cell(line, x)
+			if err != nil {
+				return nil, err
+			}
+			g.Cells = append(g.Cells, v)
+		}
+	}
+	return
+}
+
+// In reports whether p lies on the grid
+func (g *Grid[T]) In(p Point) bool {
+	return 0 <= p.X && p.X < g.Width && 0 <= p.Y && p.Y < g.Height
+}
+
+// At is the cell at p, which must be on the grid
+func (g *Grid[T]) At(p Point) T {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: %s outside %dx%d grid", p, g.Width, g.Height))
+	}
+	return g.Cells[g.index(p)]
+}
+
+// Get is the cell at p, or false when p is off the grid
+func (g *Grid[T]) Get(p Point) (v T, ok bool) {
+	if !g.In(p) {
+		return
+	}
+	return g.Cells[g.index(p)], true
+}
+
+func (g *Grid[T]) Set(p Point, v T) {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: %s outside %dx%d grid", p, g.Width, g.Height))
+	}
+	g.Cells[g.index(p)] = v
+}
+
+// Points lists every point on the grid, a row at a time
+func (g *Grid[T]) Points() []Point {
+	points := make([]Point, 0, len(g.Cells))
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			points = append(points, Point{X: x, Y: y})
+		}
+	}
+	return points
+}
+
+// Neighbours4 are the points on the grid sharing an edge with p
+func (g *Grid[T]) Neighbours4(p Point) []Point {
+	return g.neighbours(p, geom.Orthogonal)
+}
+
+// Neighbours8 are the points on the grid surrounding p, corners included
+func (g *Grid[T]) Neighbours8(p Point) []Point {
+	return g.neighbours(p, geom.Surrounding)
+}
+
+func (g *Grid[T]) neighbours(p Point, steps []Point) []Point {
+	points := make([]Point, 0, len(steps))
+	for _, step := range steps {
+		if q := p.Add(step); g.In(q) {
+			points = append(points, q)
+		}
+	}
+	return points
+}
+
+// Row shares its cells with the grid
+func (g *Grid[T]) Row(y int) []T {
+	return g.Cells[y*g.Width : (y+1)*g.Width]
+}
+
+// Column is a copy
+func (g *Grid[T]) Column(x int) []T {
+	column := make([]T, g.Height)
+	for y := range column {
+		column[y] = g.Cells[y*g.Width+x]
+	}
+	return column
+}
+
+// Walk steps away from p until it runs off the grid, listing each point
+// along the way but not p itself. Steps of Right or Down walk rows and
+// columns; DownRight and the like walk diagonals.
+func (g *Grid[T]) Walk(p Point, step Point) (points []Point) {
+	for p = p.Add(step); g.In(p); p = p.Add(step) {
+		points = append(points, p)
+	}
+	return
+}
+
+func (g *Grid[T]) Clone() *Grid[T] {
+	clone := *g
+	clone.Cells = make([]T, len(g.Cells))
+	copy(clone.Cells, g.Cells)
+	return &clone
+}
+
+// String draws the grid a row at a time. Bytes and runes are drawn as
+// characters, booleans as # and . and anything else as fmt prints it.
+func (g *Grid[T]) String() string {
+	var b strings.Builder
+	for y := 0; y < g.Height; y++ {
+		if y > 0 {
+			b.WriteByte('\n')
+		}
+		for _, v := range g.Row(y) {
+			writeCell(&b, v)
+		}
+	}
+	return b.String()
+}
+
+func writeCell(b *strings.Builder, v any) {
+	switch v := v.(type) {
+	case byte:
+		b.WriteByte(v)
+	case rune:
+		b.WriteRune(v)
+	case bool:
+		if v {
+			b.WriteByte('#')
+		} else {
+			b.WriteByte('.')
+		}
+	default:
+		fmt.Fprint(b, v)
+	}
+}
@@ -0,0 +1,171 @@
+package grid
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/util/geom"
+)
+
+func parseGrid(t *testing.T, text string) *Grid[byte] {
+	t.Helper()
+	g, err := Parse([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestParse(t *testing.T) {
+	g := parseGrid(t, "abc\ndef\n")
+	if g.Width != 3 || g.Height != 2 {
+		t.Fatalf("got %dx%d, expected 3x2", g.Width, g.Height)
+	}
+	if v := g.At(Point{X: 2, Y: 1}); v != 'f' {
+		t.Errorf("got %c at (2, 1), expected f", v)
+	}
+	if _, ok := g.Get(Point{X: 3, Y: 0}); ok {
+		t.Error("expected (3, 0) to be off the grid")
+	}
+	if got := g.String(); got != "abc\ndef" {
+		t.Errorf("got %q", got)
+	}
+
+	if _, err := Parse([]byte("abc\nde\n")); err == nil || err.Error() != "2: expected 3 columns, got 'de'" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestParseDigits(t *testing.T) {
+	g, err := ParseDigits([]byte("219\n398\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := []int{2, 1, 9, 3, 9, 8}; g.Width != 3 || !reflect.DeepEqual(g.Cells, expect) {
+		t.Errorf("got %v, expected %v", g.Cells, expect)
+	}
+
+	if _, err = ParseDigits([]byte("219\n3x8\n")); err == nil || err.Error() != "2:2: expected digit, got 'x'" {
+		t.Errorf("got %v", err)
+	}
+	if _, err = ParseDigits([]byte("219\n39\n")); err == nil || err.Error() != "2: expected 3 columns, got '39'" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := New[int](3, 3)
+	corner := Point{X: 0, Y: 0}
+	if got, expect := g.Neighbours4(corner), []Point{{X: 1, Y: 0}, {X: 0, Y: 1}}; !reflect.DeepEqual(got, expect) {
+		t.Errorf("got %v, expected %v", got, expect)
+	}
+	if got, expect := g.Neighbours8(corner), []Point{{X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}}; !reflect.DeepEqual(got, expect) {
+		t.Errorf("got %v, expected %v", got, expect)
+	}
+	if got := len(g.Neighbours8(Point{X: 1, Y: 1})); got != 8 {
+		t.Errorf("got %d neighbours in the middle, expected 8", got)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	g := parseGrid(t, "abc\ndef\nghi\n")
+	tests := []struct {
+		from   Point
+		step   Point
+		expect string
+	}{
+		{Point{X: 0, Y: 1}, geom.Right, "ef"},
+		{Point{X: 1, Y: 2}, geom.Up, "eb"},
+		{Point{X: 0, Y: 0}, geom.DownRight, "ei"},
+		{Point{X: 2, Y: 0}, geom.DownLeft, "eg"},
+		{Point{X: 2, Y: 2}, geom.Right, ""},
+	}
+	for _, test := range tests {
+		var got []byte
+		for _, p := range g.Walk(test.from, test.step) {
+			got = append(got, g.At(p))
+		}
+		if string(got) != test.expect {
+			t.Errorf("walk %s from %s: got %q, expected %q", test.step, test.from, got, test.expect)
+		}
+	}
+
+	if got := string(g.Row(1)); got != "def" {
+		t.Errorf("row 1: got %q", got)
+	}
+	if got := string(g.Column(1)); got != "beh" {
+		t.Errorf("column 1: got %q", got)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	g := parseGrid(t, "abc\ndef\n")
+	tests := []struct {
+		name   string
+		got    *Grid[byte]
+		expect string
+	}{
+		{"transpose", g.Transpose(), "ad\nbe\ncf"},
+		{"rotate right", g.RotateRight(), "da\neb\nfc"},
+		{"rotate left", g.RotateLeft(), "cf\nbe\nad"},
+		{"flip horizontal", g.FlipHorizontal(), "cba\nfed"},
+		{"flip vertical", g.FlipVertical(), "def\nabc"},
+		{"full turn", g.RotateRight().RotateRight().RotateRight().RotateRight(), "abc\ndef"},
+	}
+	for _, test := range tests {
+		if got := test.got.String(); got != test.expect {
+			t.Errorf("%s: got %q, expected %q", test.name, got, test.expect)
+		}
+	}
+	if g.String() != "abc\ndef" {
+		t.Errorf("transforms changed the original: %q", g)
+	}
+}
+
+func TestRegions(t *testing.T) {
+	g, err := ParseDigits([]byte("2199943210\n3987894921\n9856789892\n8767896789\n9899965678\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	notPeak := func(v int) bool { return v != 9 }
+
+	var sizes []int
+	for _, region := range g.Regions(notPeak) {
+		sizes = append(sizes, len(region))
+	}
+	if expect := []int{3, 9, 14, 9}; !reflect.DeepEqual(sizes, expect) {
+		t.Errorf("got sizes %v, expected %v", sizes, expect)
+	}
+
+	if got := len(g.Fill(Point{X: 9, Y: 0}, notPeak)); got != 9 {
+		t.Errorf("got %d from fill, expected 9", got)
+	}
+	if got := g.Fill(Point{X: 2, Y: 0}, notPeak); got != nil {
+		t.Errorf("got %v filling from a peak", got)
+	}
+}
+
+func TestSparse(t *testing.T) {
+	s := NewSparse[byte]()
+	if s.String() != "" || s.In(Point{}) {
+		t.Error("expected an empty grid to have no bounds")
+	}
+
+	s.Set(Point{X: 498, Y: 4}, '#')
+	s.Set(Point{X: 500, Y: 6}, '#')
+	s.Set(Point{X: 497, Y: 5}, '+')
+	if s.Min != (Point{X: 497, Y: 4}) || s.Max != (Point{X: 500, Y: 6}) {
+		t.Errorf("got bounds %s to %s", s.Min, s.Max)
+	}
+	if got, expect := s.String(), ".#..\n+...\n...#"; got != expect {
+		t.Errorf("got %q, expected %q", got, expect)
+	}
+
+	s.Delete(Point{X: 497, Y: 5})
+	if s.Has(Point{X: 497, Y: 5}) || s.Len() != 2 || !s.In(Point{X: 497, Y: 5}) {
+		t.Error("expected the cell gone and the bounds kept")
+	}
+	if got, expect := s.Dense().String(), "\x00#\x00\x00\n\x00\x00\x00\x00\n\x00\x00\x00#"; got != expect {
+		t.Errorf("got %q, expected %q", got, expect)
+	}
+}
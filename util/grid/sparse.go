@@ -0,0 +1,94 @@
+package grid
+
+import "strings"
+
+// Sparse holds cells scattered over an unbounded plane. Min and Max bound
+// every point ever set, so the grid grows but never shrinks.
+type Sparse[T any] struct {
+	Cells map[Point]T
+	Min   Point
+	Max   Point
+
+	bounded bool
+}
+
+func NewSparse[T any]() *Sparse[T] {
+	return &Sparse[T]{
+		Cells: make(map[Point]T),
+	}
+}
+
+func (s *Sparse[T]) Get(p Point) (v T, ok bool) {
+	v, ok = s.Cells[p]
+	return
+}
+
+func (s *Sparse[T]) Has(p Point) bool {
+	_, ok := s.Cells[p]
+	return ok
+}
+
+func (s *Sparse[T]) Set(p Point, v T) {
+	switch {
+	case !s.bounded:
+		s.Min, s.Max, s.bounded = p, p, true
+	case p.X < s.Min.X:
+		s.Min.X = p.X
+	case p.X > s.Max.X:
+		s.Max.X = p.X
+	}
+	if p.Y < s.Min.Y {
+		s.Min.Y = p.Y
+	} else if p.Y > s.Max.Y {
+		s.Max.Y = p.Y
+	}
+	s.Cells[p] = v
+}
+
+func (s *Sparse[T]) Delete(p Point) {
+	delete(s.Cells, p)
+}
+
+func (s *Sparse[T]) Len() int {
+	return len(s.Cells)
+}
+
+// In reports whether p lies within the bounds
+func (s *Sparse[T]) In(p Point) bool {
+	return s.bounded && s.Min.X <= p.X && p.X <= s.Max.X && s.Min.Y <= p.Y && p.Y <= s.Max.Y
+}
+
+// Dense copies the cells within the bounds into a grid, with Min moved to
+// the top left corner
+func (s *Sparse[T]) Dense() *Grid[T] {
+	if !s.bounded {
+		return new(Grid[T])
+	}
+	g := New[T](s.Max.X-s.Min.X+1, s.Max.Y-s.Min.Y+1)
+	for p, v := range s.Cells {
+		g.Set(Point{X: p.X - s.Min.X, Y: p.Y - s.Min.Y}, v)
+	}
+	return g
+}
+
+// String draws the bounds a row at a time as Grid does, with . wherever no
+// cell is set
+func (s *Sparse[T]) String() string {
+	var b strings.Builder
+	if !s.bounded {
+		return ""
+	}
+	for y := s.Min.Y; y <= s.Max.Y; y++ {
+		if y > s.Min.Y {
+			b.WriteByte('\n')
+		}
+		for x := s.Min.X; x <= s.Max.X; x++ {
+			if v, ok := s.Cells[Point{X: x, Y: y}]; ok {
+				writeCell(&b, v)
+			} else {
+				b.WriteByte('.')
+			}
+		}
+	}
+	return b.String()
+}
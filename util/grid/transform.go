@@ -0,0 +1,50 @@
+package grid
+
+// Each transform returns a new grid and leaves the original alone
+
+// Transpose swaps rows and columns
+func (g *Grid[T]) Transpose() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(x, y int) Point {
+		return Point{X: y, Y: x}
+	})
+}
+
+// RotateRight turns the grid a quarter turn clockwise
+func (g *Grid[T]) RotateRight() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(x, y int) Point {
+		return Point{X: y, Y: g.Height - 1 - x}
+	})
+}
+
+// RotateLeft turns the grid a quarter turn anticlockwise
+func (g *Grid[T]) RotateLeft() *Grid[T] {
+	return g.remap(g.Height, g.Width, func(x, y int) Point {
+		return Point{X: g.Width - 1 - y, Y: x}
+	})
+}
+
+// FlipHorizontal mirrors the grid left to right
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	return g.remap(g.Width, g.Height, func(x, y int) Point {
+		return Point{X: g.Width - 1 - x, Y: y}
+	})
+}
+
+// FlipVertical mirrors the grid top to bottom
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	return g.remap(g.Width, g.Height, func(x, y int) Point {
+		return Point{X: x, Y: g.Height - 1 - y}
+	})
+}
+
+// remap builds a width by height grid, taking each cell from the point of g
+// that from gives
+func (g *Grid[T]) remap(width int, height int, from func(x, y int) Point) *Grid[T] {
+	out := New[T](width, height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			out.Cells[y*width+x] = g.At(from(x, y))
+		}
+	}
+	return out
+}
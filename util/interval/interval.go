@@ -0,0 +1,68 @@
+// Package interval works with ranges of integers: seed numbers, cleaning
+// assignments, the stretch of a row a sensor can see. An Interval includes
+// both of its ends, the way puzzles write them; 2-4 is 2, 3 and 4.
+package interval
+
+import "fmt"
+
+// Interval holds every integer from Lo to Hi. It is empty when Hi is below
+// Lo.
+type Interval struct {
+	Lo int
+	Hi int
+}
+
+func New(lo, hi int) Interval {
+	return Interval{Lo: lo, Hi: hi}
+}
+
+// FromLength is the interval of length numbers counting up from start
+func FromLength(start, length int) Interval {
+	return Interval{Lo: start, Hi: start + length - 1}
+}
+
+func (i Interval) Empty() bool {
+	return i.Hi < i.Lo
+}
+
+// Len counts the numbers in the interval
+func (i Interval) Len() int {
+	if i.Empty() {
+		return 0
+	}
+	return i.Hi - i.Lo + 1
+}
+
+func (i Interval) Contains(n int) bool {
+	return i.Lo <= n && n <= i.Hi
+}
+
+// Covers reports whether every number in j is also in i
+func (i Interval) Covers(j Interval) bool {
+	return j.Empty() || (i.Lo <= j.Lo && j.Hi <= i.Hi)
+}
+
+// Overlaps reports whether i and j share at least one number
+func (i Interval) Overlaps(j Interval) bool {
+	return !i.Intersect(j).Empty()
+}
+
+// Intersect is the numbers in both i and j, which may be empty
+func (i Interval) Intersect(j Interval) Interval {
+	if j.Lo > i.Lo {
+		i.Lo = j.Lo
+	}
+	if j.Hi < i.Hi {
+		i.Hi = j.Hi
+	}
+	return i
+}
+
+// Shift moves the interval by delta
+func (i Interval) Shift(delta int) Interval {
+	return Interval{Lo: i.Lo + delta, Hi: i.Hi + delta}
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d, %d]", i.Lo, i.Hi)
+}
@@ -0,0 +1,166 @@
+package interval
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// Property tests compare every operation against plain membership over a
+// small stretch of numbers, where brute force is cheap
+const lo, hi = -30, 30
+
+func randomInterval(r *rand.Rand) Interval {
+	start := lo + r.Intn(hi-lo)
+	// Now and then an empty interval
+	return FromLength(start, r.Intn(12)-1)
+}
+
+func randomSet(r *rand.Rand) Set {
+	intervals := make([]Interval, r.Intn(6))
+	for i := range intervals {
+		intervals[i] = randomInterval(r)
+	}
+	return Merge(intervals...)
+}
+
+func members(s Set) map[int]bool {
+	m := make(map[int]bool)
+	for n := lo - 20; n <= hi+20; n++ {
+		if s.Contains(n) {
+			m[n] = true
+		}
+	}
+	return m
+}
+
+// canonical checks the set is sorted, with no empty or touching intervals
+func canonical(t *testing.T, s Set) {
+	t.Helper()
+	for i, interval := range s.intervals {
+		if interval.Empty() {
+			t.Fatalf("%s holds an empty interval", s)
+		}
+		if i > 0 && s.intervals[i-1].Hi+1 >= interval.Lo {
+			t.Fatalf("%s holds touching intervals", s)
+		}
+	}
+}
+
+func TestInterval(t *testing.T) {
+	a, b := New(2, 8), New(3, 7)
+	if !a.Covers(b) || b.Covers(a) {
+		t.Errorf("expected %s to cover %s and not the other way", a, b)
+	}
+	if !New(5, 7).Overlaps(New(7, 9)) || New(2, 4).Overlaps(New(6, 8)) {
+		t.Error("wrong overlaps")
+	}
+	if got := FromLength(79, 14); got != New(79, 92) || got.Len() != 14 {
+		t.Errorf("got %s", got)
+	}
+	if got := New(4, 2); !got.Empty() || got.Len() != 0 {
+		t.Errorf("expected %s to be empty", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	s := Merge(New(12, 14), New(-2, 2), New(3, 4), New(16, 20), New(0, 1), New(9, 8))
+	expect := []Interval{{Lo: -2, Hi: 4}, {Lo: 12, Hi: 14}, {Lo: 16, Hi: 20}}
+	if !reflect.DeepEqual(s.Intervals(), expect) {
+		t.Errorf("got %s", s)
+	}
+	if s.Len() != 15 || s.Min() != -2 || s.Max() != 20 {
+		t.Errorf("got %d numbers from %d to %d", s.Len(), s.Min(), s.Max())
+	}
+	if (Set{}).Len() != 0 || !(Set{}).Empty() || (Set{}).Contains(0) {
+		t.Error("expected the zero set to be empty")
+	}
+}
+
+func TestSetProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for round := 0; round < 2000; round++ {
+		s, u := randomSet(r), randomSet(r)
+		canonical(t, s)
+		ms, mu := members(s), members(u)
+
+		if s.Len() != len(ms) {
+			t.Fatalf("%s: got length %d, expected %d", s, s.Len(), len(ms))
+		}
+
+		union, both, rest := s.Union(u), s.Intersect(u), s.Difference(u)
+		for _, set := range []Set{union, both, rest} {
+			canonical(t, set)
+		}
+		for n := lo - 20; n <= hi+20; n++ {
+			if union.Contains(n) != (ms[n] || mu[n]) {
+				t.Fatalf("%d in %s union %s", n, s, u)
+			}
+			if both.Contains(n) != (ms[n] && mu[n]) {
+				t.Fatalf("%d in %s intersect %s", n, s, u)
+			}
+			if rest.Contains(n) != (ms[n] && !mu[n]) {
+				t.Fatalf("%d in %s minus %s", n, s, u)
+			}
+		}
+
+		i := randomInterval(r)
+		covered := true
+		for n := i.Lo; n <= i.Hi; n++ {
+			covered = covered && ms[n]
+		}
+		if s.Covers(i) != covered {
+			t.Fatalf("%s covers %s: got %t", s, i, !covered)
+		}
+		if added := s.Add(i); !reflect.DeepEqual(added, s.Union(Merge(i))) {
+			t.Fatalf("%s add %s: got %s", s, i, added)
+		}
+	}
+}
+
+func TestMapping(t *testing.T) {
+	// The seed-to-soil map from 2023 day 5
+	m, err := NewMapping(
+		Offset{Interval: FromLength(98, 2), Delta: 50 - 98},
+		Offset{Interval: FromLength(50, 48), Delta: 52 - 50},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for seed, soil := range map[int]int{79: 81, 14: 14, 55: 57, 13: 13, 98: 50, 99: 51, 100: 100} {
+		if got := m.Map(seed); got != soil {
+			t.Errorf("seed %d: got soil %d, expected %d", seed, got, soil)
+		}
+	}
+	if got := m.Apply(Merge(FromLength(79, 14), FromLength(55, 13))); !reflect.DeepEqual(got, Merge(New(57, 69), New(81, 94))) {
+		t.Errorf("got %s", got)
+	}
+
+	if _, err := NewMapping(Offset{Interval: New(1, 5)}, Offset{Interval: New(5, 9)}); err == nil {
+		t.Error("expected overlapping offsets to fail")
+	}
+}
+
+func TestMappingProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(24))
+	for round := 0; round < 2000; round++ {
+		// Offsets cut from a merged set never overlap
+		var offsets []Offset
+		for _, i := range randomSet(r).intervals {
+			offsets = append(offsets, Offset{Interval: i, Delta: r.Intn(21) - 10})
+		}
+		m, err := NewMapping(offsets...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := randomSet(r)
+		var moved []Interval
+		for n := range members(s) {
+			moved = append(moved, New(m.Map(n), m.Map(n)))
+		}
+		if got, expect := m.Apply(s), Merge(moved...); !reflect.DeepEqual(got, expect) {
+			t.Fatalf("%v applied to %s: got %s, expected %s", offsets, s, got, expect)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package interval
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Offset moves every number in an interval by Delta
+type Offset struct {
+	Interval
+	Delta int
+}
+
+func (o Offset) String() string {
+	return fmt.Sprintf("%s%+d", o.Interval, o.Delta)
+}
+
+// Mapping moves numbers piece by piece: a number inside one of its offsets
+// moves by that offset's delta and any other number stays where it is
+type Mapping struct {
+	offsets []Offset
+}
+
+// NewMapping sorts the offsets and checks that no number falls in two of
+// them
+func NewMapping(offsets ...Offset) (m Mapping, err error) {
+	m.offsets = make([]Offset, 0, len(offsets))
+	for _, o := range offsets {
+		if o.Empty() {
+			return Mapping{}, fmt.Errorf("empty offset %s", o)
+		}
+		m.offsets = append(m.offsets, o)
+	}
+	sort.Slice(m.offsets, func(a, b int) bool {
+		return m.offsets[a].Lo < m.offsets[b].Lo
+	})
+	for i := 1; i < len(m.offsets); i++ {
+		if prev, o := m.offsets[i-1], m.offsets[i]; prev.Overlaps(o.Interval) {
+			return Mapping{}, fmt.Errorf("offsets %s and %s overlap", prev, o)
+		}
+	}
+	return
+}
+
+// Map moves a single number
+func (m Mapping) Map(n int) int {
+	i := sort.Search(len(m.offsets), func(i int) bool {
+		return m.offsets[i].Hi >= n
+	})
+	if i < len(m.offsets) && m.offsets[i].Contains(n) {
+		return n + m.offsets[i].Delta
+	}
+	return n
+}
+
+// Apply moves every number in the set at once, splitting its intervals
+// wherever they straddle the edge of an offset
+func (m Mapping) Apply(s Set) Set {
+	covered := make([]Interval, len(m.offsets))
+	moved := make([]Interval, 0, len(s.intervals)+len(m.offsets))
+	for i, o := range m.offsets {
+		covered[i] = o.Interval
+		for _, inside := range s.Intersect(Merge(o.Interval)).intervals {
+			moved = append(moved, inside.Shift(o.Delta))
+		}
+	}
+	stay := s.Difference(Merge(covered...))
+	return Merge(append(moved, stay.intervals...)...)
+}
@@ -0,0 +1,147 @@
+package interval
+
+import (
+	"sort"
+	"strings"
+)
+
+// Set is any collection of integers, held as the fewest intervals that cover
+// it: sorted, never empty, and with a gap between each. The zero Set is
+// empty. Sets are values; every operation returns a new one.
+type Set struct {
+	intervals []Interval
+}
+
+// Merge joins intervals that overlap or touch, in any order, into a Set
+func Merge(intervals ...Interval) Set {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if !i.Empty() {
+			sorted = append(sorted, i)
+		}
+	}
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Lo < sorted[b].Lo
+	})
+
+	var s Set
+	for _, i := range sorted {
+		last := len(s.intervals) - 1
+		// Touching counts too: [1, 2] and [3, 4] are [1, 4]
+		if last >= 0 && i.Lo <= s.intervals[last].Hi+1 {
+			if i.Hi > s.intervals[last].Hi {
+				s.intervals[last].Hi = i.Hi
+			}
+			continue
+		}
+		s.intervals = append(s.intervals, i)
+	}
+	return s
+}
+
+// Intervals lists the set's intervals from lowest to highest
+func (s Set) Intervals() []Interval {
+	intervals := make([]Interval, len(s.intervals))
+	copy(intervals, s.intervals)
+	return intervals
+}
+
+func (s Set) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Len counts the numbers in the set
+func (s Set) Len() (n int) {
+	for _, i := range s.intervals {
+		n += i.Len()
+	}
+	return
+}
+
+// Min is the lowest number in the set, which must not be empty
+func (s Set) Min() int {
+	return s.intervals[0].Lo
+}
+
+// Max is the highest number in the set, which must not be empty
+func (s Set) Max() int {
+	return s.intervals[len(s.intervals)-1].Hi
+}
+
+func (s Set) Contains(n int) bool {
+	i := s.find(n)
+	return i < len(s.intervals) && s.intervals[i].Contains(n)
+}
+
+// Covers reports whether every number in j is in the set
+func (s Set) Covers(j Interval) bool {
+	if j.Empty() {
+		return true
+	}
+	i := s.find(j.Lo)
+	return i < len(s.intervals) && s.intervals[i].Covers(j)
+}
+
+// find is the index of the first interval that does not end before n
+func (s Set) find(n int) int {
+	return sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].Hi >= n
+	})
+}
+
+// Add is the set with i included
+func (s Set) Add(i Interval) Set {
+	return Merge(append(s.Intervals(), i)...)
+}
+
+// Union is every number in either set
+func (s Set) Union(t Set) Set {
+	return Merge(append(s.Intervals(), t.intervals...)...)
+}
+
+// Intersect is every number in both sets
+func (s Set) Intersect(t Set) (both Set) {
+	// Walk both lists together, advancing whichever interval ends first
+	for a, b := 0, 0; a < len(s.intervals) && b < len(t.intervals); {
+		if i := s.intervals[a].Intersect(t.intervals[b]); !i.Empty() {
+			both.intervals = append(both.intervals, i)
+		}
+		if s.intervals[a].Hi < t.intervals[b].Hi {
+			a++
+		} else {
+			b++
+		}
+	}
+	return
+}
+
+// Difference is every number in s but not in t
+func (s Set) Difference(t Set) (rest Set) {
+	b := 0
+	for _, i := range s.intervals {
+		// Skip what ends before this interval starts, then cut away
+		// whatever of t lies inside it
+		for b < len(t.intervals) && t.intervals[b].Hi < i.Lo {
+			b++
+		}
+		for k := b; k < len(t.intervals) && t.intervals[k].Lo <= i.Hi; k++ {
+			cut := t.intervals[k]
+			if cut.Lo > i.Lo {
+				rest.intervals = append(rest.intervals, Interval{Lo: i.Lo, Hi: cut.Lo - 1})
+			}
+			i.Lo = cut.Hi + 1
+		}
+		if !i.Empty() {
+			rest.intervals = append(rest.intervals, i)
+		}
+	}
+	return
+}
+
+func (s Set) String() string {
+	parts := make([]string, len(s.intervals))
+	for i, interval := range s.intervals {
+		parts[i] = interval.String()
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
@@ -0,0 +1,64 @@
+package numtheory
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNoSolution = errors.New("no solution")
+
+// Congruence is the set of numbers x where x mod Mod is Rem
+type Congruence struct {
+	Rem int
+	Mod int
+}
+
+func (c Congruence) String() string {
+	return fmt.Sprintf("x ≡ %d (mod %d)", c.Rem, c.Mod)
+}
+
+// CRT combines congruences into the one congruence holding every number that
+// satisfies them all, so its Rem is the smallest such number that is not
+// negative. The moduli need not be coprime: x ≡ 2 (mod 4) and x ≡ 4 (mod 6)
+// combine into x ≡ 10 (mod 12), while x ≡ 1 (mod 4) and x ≡ 2 (mod 6) have
+// no solution and fail with ErrNoSolution.
+// Ref: https://en.wikipedia.org/wiki/Chinese_remainder_theorem
+func CRT(congruences ...Congruence) (Congruence, error) {
+	combined := Congruence{Rem: 0, Mod: 1}
+	for _, c := range congruences {
+		if c.Mod < 1 {
+			return Congruence{}, fmt.Errorf("modulus %d is not positive", c.Mod)
+		}
+		next, err := combine(combined, Congruence{Rem: Mod(c.Rem, c.Mod), Mod: c.Mod})
+		if err != nil {
+			return Congruence{}, fmt.Errorf("%s and %s: %w", combined, c, err)
+		}
+		combined = next
+	}
+	return combined, nil
+}
+
+// combine solves a pair of congruences whose remainders are already reduced.
+// Writing x = a.Rem + a.Mod*t, t must satisfy
+// a.Mod*t ≡ b.Rem - a.Rem (mod b.Mod), which only has a solution when the
+// GCD of the moduli divides the difference.
+func combine(a, b Congruence) (Congruence, error) {
+	g := GCD(a.Mod, b.Mod)
+	diff := b.Rem - a.Rem
+	if diff%g != 0 {
+		return Congruence{}, ErrNoSolution
+	}
+	l, err := Mul(a.Mod/g, b.Mod)
+	if err != nil {
+		return Congruence{}, err
+	}
+
+	m := b.Mod / g
+	inverse, err := ModInverse(a.Mod/g, m)
+	if err != nil {
+		return Congruence{}, err
+	}
+	t := MulMod(diff/g, inverse, m)
+	// a.Rem < a.Mod and t < m, so this stays below l
+	return Congruence{Rem: a.Rem + a.Mod*t, Mod: l}, nil
+}
@@ -0,0 +1,127 @@
+// Package numtheory has the integer arithmetic that cycle puzzles lean on:
+// common divisors and multiples, modular arithmetic, and the Chinese
+// Remainder Theorem. Anything that could overflow an int reports
+// ErrOverflow rather than wrapping around.
+package numtheory
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+var ErrOverflow = errors.New("integer overflow")
+
+// GCD is the greatest common divisor of nums, ignoring signs. Zeros are
+// ignored too, so GCD of nothing but zeros is 0.
+// Ref: https://en.wikipedia.org/wiki/Euclidean_algorithm
+func GCD(nums ...int) (g int) {
+	for _, n := range nums {
+		a, b := g, abs(n)
+		for b != 0 {
+			a, b = b, a%b
+		}
+		g = a
+	}
+	return
+}
+
+// LCM is the least common multiple of nums, ignoring signs. It is 0 when any
+// of nums is 0 and 1 when there are none.
+func LCM(nums ...int) (l int, err error) {
+	l = 1
+	for _, n := range nums {
+		n = abs(n)
+		if n == 0 {
+			return 0, nil
+		}
+		// Divide before multiplying so only a result that is truly too
+		// big overflows
+		if l, err = Mul(l/GCD(l, n), n); err != nil {
+			return 0, err
+		}
+	}
+	return
+}
+
+// Mul multiplies a and b, or fails if the product does not fit in an int
+func Mul(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, ErrOverflow
+	}
+	return product, nil
+}
+
+// ExtendedGCD finds g, the GCD of a and b, along with x and y such that
+// a*x + b*y = g
+// Ref: https://en.wikipedia.org/wiki/Extended_Euclidean_algorithm
+func ExtendedGCD(a, b int) (g, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// Mod is the remainder of a divided by m, always from 0 to m-1 even for a
+// negative a. m must be positive.
+func Mod(a, m int) int {
+	r := a % m
+	if r < 0 {
+		r += m
+	}
+	return r
+}
+
+// MulMod is a*b mod m, worked in 128 bits so that it cannot overflow. m must
+// be positive.
+func MulMod(a, b, m int) int {
+	hi, lo := bits.Mul64(uint64(Mod(a, m)), uint64(Mod(b, m)))
+	return int(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// PowMod is base raised to exp, mod m, by repeated squaring. exp must not be
+// negative and m must be positive.
+func PowMod(base, exp, m int) int {
+	if exp < 0 {
+		panic("numtheory: negative exponent")
+	}
+	result, base := Mod(1, m), Mod(base, m)
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = MulMod(result, base, m)
+		}
+		base = MulMod(base, base, m)
+	}
+	return result
+}
+
+// ModInverse finds x such that a*x mod m is 1, which exists only when a and
+// m share no factor
+func ModInverse(a, m int) (int, error) {
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%d has no inverse mod %d", a, m)
+	}
+	return Mod(x, m), nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
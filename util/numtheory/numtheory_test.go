@@ -0,0 +1,145 @@
+package numtheory
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		nums   []int
+		expect int
+	}{
+		{[]int{12, 18}, 6},
+		{[]int{-12, 18, 27}, 3},
+		{[]int{0, 7}, 7},
+		{[]int{0, 0}, 0},
+		{nil, 0},
+	}
+	for _, test := range tests {
+		if got := GCD(test.nums...); got != test.expect {
+			t.Errorf("GCD%v: got %d, expected %d", test.nums, got, test.expect)
+		}
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		nums   []int
+		expect int
+	}{
+		{[]int{4, 6}, 12},
+		{[]int{23, 19, 13, 17}, 96577},
+		{[]int{-3, 5}, 15},
+		{[]int{4, 0}, 0},
+		{nil, 1},
+		// Multiplying first would overflow even though the answer fits
+		{[]int{1 << 40, 1 << 41}, 1 << 41},
+	}
+	for _, test := range tests {
+		if got, err := LCM(test.nums...); err != nil || got != test.expect {
+			t.Errorf("LCM%v: got %d, %v, expected %d", test.nums, got, err, test.expect)
+		}
+	}
+
+	if _, err := LCM(1<<40+1, 1<<40-1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got %v, expected overflow", err)
+	}
+	if _, err := Mul(math.MinInt, -1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got %v, expected overflow", err)
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	r := rand.New(rand.NewSource(25))
+	for i := 0; i < 1000; i++ {
+		a, b := r.Intn(2000)-1000, r.Intn(2000)-1000
+		g, x, y := ExtendedGCD(a, b)
+		if g != GCD(a, b) || a*x+b*y != g {
+			t.Fatalf("ExtendedGCD(%d, %d): got %d, %d, %d", a, b, g, x, y)
+		}
+	}
+}
+
+func TestModular(t *testing.T) {
+	if got := Mod(-7, 5); got != 3 {
+		t.Errorf("Mod(-7, 5): got %d, expected 3", got)
+	}
+	if got, err := ModInverse(3, 11); err != nil || got != 4 {
+		t.Errorf("ModInverse(3, 11): got %d, %v, expected 4", got, err)
+	}
+	if _, err := ModInverse(6, 9); err == nil {
+		t.Error("expected 6 to have no inverse mod 9")
+	}
+
+	r := rand.New(rand.NewSource(8))
+	for i := 0; i < 1000; i++ {
+		base, exp, m := r.Int()-r.Int(), r.Intn(1<<20), r.Int()+1
+		expect := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(exp)), big.NewInt(int64(m)))
+		if expect.Sign() < 0 {
+			expect.Add(expect, big.NewInt(int64(m)))
+		}
+		if got := PowMod(base, exp, m); int64(got) != expect.Int64() {
+			t.Fatalf("PowMod(%d, %d, %d): got %d, expected %s", base, exp, m, got, expect)
+		}
+	}
+}
+
+func TestCRT(t *testing.T) {
+	got, err := CRT(Congruence{Rem: 2, Mod: 4}, Congruence{Rem: 4, Mod: 6})
+	if err != nil || got != (Congruence{Rem: 10, Mod: 12}) {
+		t.Errorf("got %s, %v", got, err)
+	}
+	if _, err := CRT(Congruence{Rem: 1, Mod: 4}, Congruence{Rem: 2, Mod: 6}); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("got %v, expected no solution", err)
+	}
+	if _, err := CRT(Congruence{Rem: 1, Mod: 0}); err == nil {
+		t.Error("expected a zero modulus to fail")
+	}
+
+	// Moduli big enough that their product overflows
+	huge := []Congruence{{Rem: 1, Mod: 1<<31 - 1}, {Rem: 2, Mod: 1<<61 - 1}}
+	if _, err := CRT(huge...); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got %v, expected overflow", err)
+	}
+}
+
+// CRT must find exactly the numbers brute force finds
+func TestCRTProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	for round := 0; round < 2000; round++ {
+		congruences := make([]Congruence, 1+r.Intn(3))
+		for i := range congruences {
+			m := 1 + r.Intn(12)
+			congruences[i] = Congruence{Rem: r.Intn(3 * m), Mod: m}
+		}
+		got, err := CRT(congruences...)
+
+		l, _ := LCM(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+		var solutions []int
+		for x := 0; x < l; x++ {
+			fits := true
+			for _, c := range congruences {
+				fits = fits && x%c.Mod == c.Rem%c.Mod
+			}
+			if fits {
+				solutions = append(solutions, x)
+			}
+		}
+
+		if len(solutions) == 0 {
+			if !errors.Is(err, ErrNoSolution) {
+				t.Fatalf("%v: got %s, %v, expected no solution", congruences, got, err)
+			}
+			continue
+		}
+		// Solutions repeat evenly, so l holds a whole number of them
+		expect := Congruence{Rem: solutions[0], Mod: l / len(solutions)}
+		if err != nil || got != expect {
+			t.Fatalf("%v: got %s, %v, expected %s", congruences, got, err, expect)
+		}
+	}
+}
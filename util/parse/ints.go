@@ -0,0 +1,52 @@
+package parse
+
+import "github.com/jbaikge/advent-of-code/util"
+
+// IntFields finds every integer in the line, whatever surrounds it. A minus
+// sign counts unless it follows a digit, so x=-3 is -3 while 2-4 is a range
+// from 2 to 4.
+func (l Line) IntFields() (fields []util.Field) {
+	text := l.Text
+	for i := 0; i < len(text); i++ {
+		start := i
+		if text[i] == '-' && (i == 0 || !isDigit(text[i-1])) && i+1 < len(text) && isDigit(text[i+1]) {
+			i++
+		}
+		if !isDigit(text[i]) {
+			continue
+		}
+		for i+1 < len(text) && isDigit(text[i+1]) {
+			i++
+		}
+		fields = append(fields, util.Field{Text: text[start : i+1], Col: start + 1})
+	}
+	return
+}
+
+// Ints extracts every integer in the line, as IntFields finds them
+func (l Line) Ints() (ints []int, err error) {
+	fields := l.IntFields()
+	ints = make([]int, len(fields))
+	for i, field := range fields {
+		if ints[i], err = l.Atoi(field); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// Ints extracts every integer from each line in turn
+func Ints(lines []Line) (ints []int, err error) {
+	for _, line := range lines {
+		var more []int
+		if more, err = line.Ints(); err != nil {
+			return nil, err
+		}
+		ints = append(ints, more...)
+	}
+	return
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
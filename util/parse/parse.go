@@ -0,0 +1,85 @@
+// Package parse reads puzzle inputs a line at a time. Every line remembers
+// where it came from, so errors about it carry a line and column.
+package parse
+
+import (
+	"io"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+// Line is one line of input; No counts from 1
+type Line struct {
+	No   int
+	Text string
+}
+
+// Lines splits data the way bufio.ScanLines does: no trailing empty line and
+// no carriage returns
+func Lines(data []byte) (lines []Line) {
+	text := string(data)
+	for no := 1; text != ""; no++ {
+		line, rest, _ := strings.Cut(text, "\n")
+		lines = append(lines, Line{No: no, Text: strings.TrimSuffix(line, "\r")})
+		text = rest
+	}
+	return
+}
+
+// ReadLines is Lines for solutions handed an io.Reader
+func ReadLines(r io.Reader) (lines []Line, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	return Lines(data), nil
+}
+
+// Paragraphs groups lines into blocks separated by blank lines. Runs of blank
+// lines never produce an empty block.
+func Paragraphs(lines []Line) (paragraphs [][]Line) {
+	start := -1
+	for i, line := range lines {
+		switch {
+		case line.Blank() && start >= 0:
+			paragraphs = append(paragraphs, lines[start:i])
+			start = -1
+		case !line.Blank() && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		paragraphs = append(paragraphs, lines[start:])
+	}
+	return
+}
+
+func (l Line) Blank() bool {
+	return strings.TrimSpace(l.Text) == ""
+}
+
+// Errorf reports text found at col on this line
+func (l Line) Errorf(col int, text string, format string, args ...any) error {
+	return util.Errorf(l.No, col, text, format, args...)
+}
+
+// Fields splits the line around runs of white space
+func (l Line) Fields() []util.Field {
+	return util.Fields(l.Text)
+}
+
+// FieldsFunc splits the line around runs of runes satisfying f
+func (l Line) FieldsFunc(f func(rune) bool) []util.Field {
+	return util.FieldsFunc(l.Text, f)
+}
+
+// Int converts the whole line
+func (l Line) Int() (int, error) {
+	return util.Atoi(l.No, 1, l.Text)
+}
+
+// Atoi converts a field of this line
+func (l Line) Atoi(field util.Field) (int, error) {
+	return util.Atoi(l.No, field.Col, field.Text)
+}
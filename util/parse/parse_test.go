@@ -0,0 +1,93 @@
+package parse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	lines := Lines([]byte("1000\r\n2000\n\n3000\n"))
+	expect := []Line{{1, "1000"}, {2, "2000"}, {3, ""}, {4, "3000"}}
+	if !reflect.DeepEqual(lines, expect) {
+		t.Errorf("got %v, expected %v", lines, expect)
+	}
+	if lines := Lines(nil); len(lines) != 0 {
+		t.Errorf("got %v from no input", lines)
+	}
+}
+
+func TestParagraphs(t *testing.T) {
+	paragraphs := Paragraphs(Lines([]byte("\n1\n2\n\n\n3\n \n")))
+	expect := [][]Line{{{2, "1"}, {3, "2"}}, {{6, "3"}}}
+	if !reflect.DeepEqual(paragraphs, expect) {
+		t.Errorf("got %v, expected %v", paragraphs, expect)
+	}
+}
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		text   string
+		expect []int
+	}{
+		{"Sensor at x=-2, y=15: closest beacon is at x=10, y=-16", []int{-2, 15, 10, -16}},
+		{"2-4,6-8", []int{2, 4, 6, 8}},
+		{"  Starting items: 79, 98", []int{79, 98}},
+		{"seeds: 79 14 55 13", []int{79, 14, 55, 13}},
+		{"no numbers - here", []int{}},
+	}
+	for _, test := range tests {
+		ints, err := Line{1, test.text}.Ints()
+		if err != nil {
+			t.Errorf("%q: %v", test.text, err)
+			continue
+		}
+		if !reflect.DeepEqual(ints, test.expect) {
+			t.Errorf("%q: got %v, expected %v", test.text, ints, test.expect)
+		}
+	}
+
+	_, err := Line{3, "x=99999999999999999999"}.Ints()
+	if err == nil || err.Error() != "3:3: integer out of range, got '99999999999999999999'" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	var x, y int
+	var name, rest string
+	line := Line{1, "Valve AA has flow rate=-13; tunnels lead to valves DD, II"}
+	if err := line.Scan("Valve %s has flow rate=%d; tunnels lead to valves %s", &name, &x, &rest); err != nil {
+		t.Fatal(err)
+	}
+	if name != "AA" || x != -13 || rest != "DD, II" {
+		t.Errorf("got %q, %d, %q", name, x, rest)
+	}
+
+	tests := []struct {
+		text   string
+		expect string
+	}{
+		{"Sensor at x=2, y=18", ""},
+		{"Sensor at x=2, y=", "7:18: expected integer"},
+		{"Sensor at x=a, y=18", "7:13: expected integer, got 'a,'"},
+		{"Sensor at x=2; y=18", "7:14: expected ', y=', got '; y='"},
+		{"Sensor at x=2, y=18 and more", "7:20: unexpected text, got ' and more'"},
+		{"100% at x=2, y=3", "7:1: expected 'Sensor at x=', got '100% at x=2,'"},
+	}
+	for _, test := range tests {
+		err := Line{7, test.text}.Scan("Sensor at x=%d, y=%d", &x, &y)
+		switch {
+		case test.expect == "" && err != nil:
+			t.Errorf("%q: %v", test.text, err)
+		case test.expect != "" && (err == nil || err.Error() != test.expect):
+			t.Errorf("%q: got %v, expected %s", test.text, err, test.expect)
+		}
+	}
+
+	if err := (Line{1, "100% done"}).Scan("%d%% %s", &x, &name); err != nil || x != 100 || name != "done" {
+		t.Errorf("got %d, %q, %v", x, name, err)
+	}
+	if err := (Line{1, "1"}).Scan("%d %d", &x); err == nil {
+		t.Error("expected an error with too few arguments")
+	}
+}
@@ -0,0 +1,140 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jbaikge/advent-of-code/util"
+)
+
+// Scan matches the line against pattern and stores what the verbs capture in
+// args, in order:
+//
+//	%d  an integer, optionally negative, into an *int
+//	%s  text up to whatever the pattern expects next, or to the end of the
+//	    line when the verb comes last, into a *string
+//	%%  a literal percent sign
+//
+// Everything else in the pattern must appear in the line exactly, and the
+// whole line must be used up. A %s must be followed by a literal or end the
+// pattern, or there is no telling where it stops. For example:
+//
+//	line.Scan("Sensor at x=%d, y=%d", &x, &y)
+func (l Line) Scan(pattern string, args ...any) (err error) {
+	text, col := l.Text, 1
+	advance := func(n int) {
+		text, col = text[n:], col+n
+	}
+
+	pieces := split(pattern)
+	for i, piece := range pieces {
+		if piece.verb == 0 {
+			if !strings.HasPrefix(text, piece.literal) {
+				return l.Errorf(col, head(text, len(piece.literal)), "expected '%s'", piece.literal)
+			}
+			advance(len(piece.literal))
+			continue
+		}
+
+		if len(args) == 0 {
+			return fmt.Errorf("too few arguments for pattern %q", pattern)
+		}
+		arg := args[0]
+		args = args[1:]
+
+		switch piece.verb {
+		case 'd':
+			n := intPrefix(text)
+			if n == 0 {
+				return l.Errorf(col, head(text, strings.IndexByte(text+" ", ' ')), "expected integer")
+			}
+			dest, ok := arg.(*int)
+			if !ok {
+				return fmt.Errorf("%%d needs an *int, got %T", arg)
+			}
+			if *dest, err = l.Atoi(util.Field{Text: text[:n], Col: col}); err != nil {
+				return
+			}
+			advance(n)
+		case 's':
+			n := len(text)
+			if i+1 < len(pieces) {
+				n = strings.Index(text, pieces[i+1].literal)
+			}
+			if n <= 0 {
+				return l.Errorf(col, text, "expected text")
+			}
+			dest, ok := arg.(*string)
+			if !ok {
+				return fmt.Errorf("%%s needs a *string, got %T", arg)
+			}
+			*dest = text[:n]
+			advance(n)
+		default:
+			return fmt.Errorf("unknown verb %%%c in pattern %q", piece.verb, pattern)
+		}
+	}
+
+	if text != "" {
+		return l.Errorf(col, text, "unexpected text")
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("too many arguments for pattern %q", pattern)
+	}
+	return
+}
+
+type piece struct {
+	literal string
+	verb    byte
+}
+
+// split breaks a pattern into literals and verbs
+func split(pattern string) (pieces []piece) {
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			pieces = append(pieces, piece{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' || i+1 == len(pattern) {
+			literal.WriteByte(pattern[i])
+			continue
+		}
+		i++
+		switch verb := pattern[i]; verb {
+		case '%':
+			literal.WriteByte('%')
+		default:
+			flush()
+			pieces = append(pieces, piece{verb: verb})
+		}
+	}
+	flush()
+	return
+}
+
+// intPrefix measures the integer text starts with, or 0 when there is none
+func intPrefix(text string) (n int) {
+	if strings.HasPrefix(text, "-") {
+		n++
+	}
+	digits := n
+	for n < len(text) && isDigit(text[n]) {
+		n++
+	}
+	if n == digits {
+		return 0
+	}
+	return
+}
+
+// head is at most the first n bytes of text, to show what was there instead
+func head(text string, n int) string {
+	if n < 0 || n > len(text) {
+		n = len(text)
+	}
+	return text[:n]
+}
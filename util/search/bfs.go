@@ -0,0 +1,38 @@
+package search
+
+// BFS finds the path from start to a goal with the fewest steps, where next
+// lists the nodes one step from n
+func BFS[N comparable](start N, goal func(N) bool, next func(n N) []N) (Path[N], bool) {
+	return MultiBFS([]N{start}, goal, next)
+}
+
+// MultiBFS is BFS from several starts at once, finding the shortest path from
+// whichever start lies closest to a goal. It answers "how far is the nearest
+// a from any z" in one search rather than one per start.
+func MultiBFS[N comparable](starts []N, goal func(N) bool, next func(n N) []N) (path Path[N], ok bool) {
+	from := make(map[N]N)
+	steps := make(map[N]int, len(starts))
+	queue := make([]N, 0, len(starts))
+	for _, start := range starts {
+		if _, seen := steps[start]; !seen {
+			steps[start] = 0
+			queue = append(queue, start)
+		}
+	}
+
+	for head := 0; head < len(queue); head++ {
+		current := queue[head]
+		if goal(current) {
+			return reconstruct(from, current, steps[current]), true
+		}
+		for _, n := range next(current) {
+			if _, seen := steps[n]; seen {
+				continue
+			}
+			steps[n] = steps[current] + 1
+			from[n] = current
+			queue = append(queue, n)
+		}
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package search
+
+import "container/heap"
+
+// Dijkstra finds the cheapest path from start to a goal, where edges lists
+// the steps out of n
+func Dijkstra[N comparable](start N, goal func(N) bool, edges func(n N) []Edge[N]) (Path[N], bool) {
+	return AStar(start, goal, edges, nil)
+}
+
+// MultiDijkstra is Dijkstra from several starts at once
+func MultiDijkstra[N comparable](starts []N, goal func(N) bool, edges func(n N) []Edge[N]) (Path[N], bool) {
+	return cheapest(starts, goal, edges, nil)
+}
+
+// AStar is Dijkstra steered toward the goal by estimate, a guess at the cost
+// remaining from n. The path is only sure to be cheapest when the guess
+// never overestimates, as Manhattan distance does not on a grid where every
+// step costs at least one. A nil estimate makes this Dijkstra.
+// Ref: https://en.wikipedia.org/wiki/A*_search_algorithm
+func AStar[N comparable](start N, goal func(N) bool, edges func(n N) []Edge[N], estimate func(n N) int) (Path[N], bool) {
+	return cheapest([]N{start}, goal, edges, estimate)
+}
+
+func cheapest[N comparable](starts []N, goal func(N) bool, edges func(N) []Edge[N], estimate func(N) int) (path Path[N], ok bool) {
+	if estimate == nil {
+		estimate = func(N) int { return 0 }
+	}
+
+	from := make(map[N]N)
+	costs := make(map[N]int, len(starts))
+	open := new(queue[N])
+	for _, start := range starts {
+		if _, seen := costs[start]; !seen {
+			costs[start] = 0
+			heap.Push(open, item[N]{node: start, priority: estimate(start)})
+		}
+	}
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(item[N])
+		// A node is pushed again each time a cheaper way to it turns up; the
+		// older entries are left in the queue and skipped here
+		if current.cost > costs[current.node] {
+			continue
+		}
+		if goal(current.node) {
+			return reconstruct(from, current.node, current.cost), true
+		}
+		for _, edge := range edges(current.node) {
+			cost := current.cost + edge.Cost
+			if known, seen := costs[edge.To]; seen && known <= cost {
+				continue
+			}
+			costs[edge.To] = cost
+			from[edge.To] = current.node
+			heap.Push(open, item[N]{node: edge.To, cost: cost, priority: cost + estimate(edge.To)})
+		}
+	}
+	return
+}
+
+type item[N comparable] struct {
+	node     N
+	cost     int
+	priority int
+}
+
+// queue is a min-heap of items ordered by priority, for container/heap
+type queue[N comparable] []item[N]
+
+func (q queue[N]) Len() int {
+	return len(q)
+}
+
+func (q queue[N]) Less(i, j int) bool {
+	return q[i].priority < q[j].priority
+}
+
+func (q queue[N]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *queue[N]) Push(x any) {
+	*q = append(*q, x.(item[N]))
+}
+
+func (q *queue[N]) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
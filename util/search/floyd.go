@@ -0,0 +1,89 @@
+package search
+
+// AllPairs holds the cheapest cost between every pair of nodes in a graph
+type AllPairs[N comparable] struct {
+	Nodes []N
+	index map[N]int
+	// costs[i][j] is the cost from node i to node j, or unreachable
+	costs [][]int
+	// next[i][j] is the node after i on the cheapest way to j
+	next [][]int
+}
+
+const unreachable = -1
+
+// FloydWarshall finds the cheapest cost between every pair of nodes. Edges to
+// nodes outside the list are ignored. It takes time cubic in the number of
+// nodes, so it suits the small graphs left after collapsing a bigger one.
+// Ref: https://en.wikipedia.org/wiki/Floyd%E2%80%93Warshall_algorithm
+func FloydWarshall[N comparable](nodes []N, edges func(n N) []Edge[N]) *AllPairs[N] {
+	a := &AllPairs[N]{
+		Nodes: nodes,
+		index: make(map[N]int, len(nodes)),
+		costs: make([][]int, len(nodes)),
+		next:  make([][]int, len(nodes)),
+	}
+	for i, n := range nodes {
+		a.index[n] = i
+	}
+	for i, n := range nodes {
+		a.costs[i] = make([]int, len(nodes))
+		a.next[i] = make([]int, len(nodes))
+		for j := range nodes {
+			a.costs[i][j], a.next[i][j] = unreachable, unreachable
+		}
+		a.costs[i][i], a.next[i][i] = 0, i
+		for _, edge := range edges(n) {
+			j, ok := a.index[edge.To]
+			if !ok || i == j {
+				continue
+			}
+			if cost := a.costs[i][j]; cost == unreachable || edge.Cost < cost {
+				a.costs[i][j], a.next[i][j] = edge.Cost, j
+			}
+		}
+	}
+
+	for k := range nodes {
+		for i := range nodes {
+			if a.costs[i][k] == unreachable {
+				continue
+			}
+			for j := range nodes {
+				if a.costs[k][j] == unreachable {
+					continue
+				}
+				cost := a.costs[i][k] + a.costs[k][j]
+				if a.costs[i][j] == unreachable || cost < a.costs[i][j] {
+					a.costs[i][j], a.next[i][j] = cost, a.next[i][k]
+				}
+			}
+		}
+	}
+	return a
+}
+
+// Cost is the cheapest cost from one node to another
+func (a *AllPairs[N]) Cost(from, to N) (cost int, ok bool) {
+	i, iok := a.index[from]
+	j, jok := a.index[to]
+	if !iok || !jok || a.costs[i][j] == unreachable {
+		return
+	}
+	return a.costs[i][j], true
+}
+
+// Path is the cheapest path from one node to another
+func (a *AllPairs[N]) Path(from, to N) (path Path[N], ok bool) {
+	cost, ok := a.Cost(from, to)
+	if !ok {
+		return
+	}
+	i, j := a.index[from], a.index[to]
+	path = Path[N]{Nodes: []N{from}, Cost: cost}
+	for i != j {
+		i = a.next[i][j]
+		path.Nodes = append(path.Nodes, a.Nodes[i])
+	}
+	return path, true
+}
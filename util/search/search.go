@@ -0,0 +1,55 @@
+// Package search finds shortest paths through graphs that are never built
+// up front. Instead of a graph, each search takes a function listing the
+// neighbours of a node, so a grid, a map of tunnels or a puzzle state can all
+// be searched as they are.
+//
+// Every search returns the cheapest Path it found and false when the goal
+// cannot be reached. Costs must not be negative.
+package search
+
+// Edge is a step to a neighbouring node and what taking it costs
+type Edge[N comparable] struct {
+	To   N
+	Cost int
+}
+
+// Path runs from a start to a goal, both included
+type Path[N comparable] struct {
+	Nodes []N
+	Cost  int
+}
+
+// Start is where the path begins
+func (p Path[N]) Start() N {
+	return p.Nodes[0]
+}
+
+// End is where the path finishes
+func (p Path[N]) End() N {
+	return p.Nodes[len(p.Nodes)-1]
+}
+
+// Steps counts the edges along the path, which is its cost when every edge
+// costs one
+func (p Path[N]) Steps() int {
+	return len(p.Nodes) - 1
+}
+
+// Is matches a single goal
+func Is[N comparable](goal N) func(N) bool {
+	return func(n N) bool {
+		return n == goal
+	}
+}
+
+// reconstruct follows from back to a start, where from has no entry
+func reconstruct[N comparable](from map[N]N, end N, cost int) Path[N] {
+	nodes := []N{end}
+	for n, ok := from[end]; ok; n, ok = from[n] {
+		nodes = append(nodes, n)
+	}
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+	return Path[N]{Nodes: nodes, Cost: cost}
+}
@@ -0,0 +1,171 @@
+package search
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/jbaikge/advent-of-code/util/geom"
+	"github.com/jbaikge/advent-of-code/util/grid"
+)
+
+// The example cave from 2021 day 15: entering a cell costs its digit
+const chiton = `1163751742
+1381373672
+2136511328
+3694931569
+7463417111
+1319128137
+1359912421
+3125421639
+1293138521
+2311944581
+`
+
+func riskEdges(g *grid.Grid[int]) func(geom.Point) []Edge[geom.Point] {
+	return func(p geom.Point) (edges []Edge[geom.Point]) {
+		for _, q := range g.Neighbours4(p) {
+			edges = append(edges, Edge[geom.Point]{To: q, Cost: g.At(q)})
+		}
+		return
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	g, err := grid.ParseDigits([]byte(chiton))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, end := geom.Point{X: 0, Y: 0}, geom.Point{X: g.Width - 1, Y: g.Height - 1}
+
+	path, ok := Dijkstra(start, Is(end), riskEdges(g))
+	if !ok || path.Cost != 40 {
+		t.Fatalf("got %d, %t, expected 40", path.Cost, ok)
+	}
+	if path.Start() != start || path.End() != end {
+		t.Errorf("got a path from %s to %s", path.Start(), path.End())
+	}
+	cost := 0
+	for i, p := range path.Nodes[1:] {
+		if p.Manhattan(path.Nodes[i]) != 1 {
+			t.Fatalf("step %d jumps from %s to %s", i, path.Nodes[i], p)
+		}
+		cost += g.At(p)
+	}
+	if cost != path.Cost {
+		t.Errorf("path adds up to %d, reported %d", cost, path.Cost)
+	}
+
+	guess := func(p geom.Point) int { return p.Manhattan(end) }
+	if path, ok := AStar(start, Is(end), riskEdges(g), guess); !ok || path.Cost != 40 {
+		t.Errorf("a*: got %d, %t, expected 40", path.Cost, ok)
+	}
+}
+
+func TestBFS(t *testing.T) {
+	maze, err := grid.Parse([]byte("S.#.....\n.##.###.\n....#..E\n.#.##.#.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := func(p geom.Point) (next []geom.Point) {
+		for _, q := range maze.Neighbours4(p) {
+			if maze.At(q) != '#' {
+				next = append(next, q)
+			}
+		}
+		return
+	}
+	exit := func(p geom.Point) bool { return maze.At(p) == 'E' }
+
+	path, ok := BFS(geom.Point{X: 0, Y: 0}, exit, open)
+	if !ok || path.Steps() != 13 || path.Cost != 13 {
+		t.Fatalf("got %d steps costing %d, %t, expected 13", path.Steps(), path.Cost, ok)
+	}
+
+	starts := []geom.Point{{X: 0, Y: 0}, {X: 5, Y: 3}}
+	if path, ok := MultiBFS(starts, exit, open); !ok || path.Cost != 3 || path.Start() != starts[1] {
+		t.Errorf("multi: got %v, %t, expected 3 steps from %s", path.Nodes, ok, starts[1])
+	}
+
+	walled := func(p geom.Point) bool { return p == geom.Point{X: 7, Y: 3} }
+	if _, ok := BFS(geom.Point{X: 0, Y: 0}, walled, func(geom.Point) []geom.Point { return nil }); ok {
+		t.Error("expected no path without any edges")
+	}
+}
+
+// randomGraph has n nodes with a few one-way edges each
+func randomGraph(r *rand.Rand, n int) map[int][]Edge[int] {
+	graph := make(map[int][]Edge[int], n)
+	for from := 0; from < n; from++ {
+		for i := r.Intn(4); i > 0; i-- {
+			graph[from] = append(graph[from], Edge[int]{To: r.Intn(n), Cost: r.Intn(10)})
+		}
+	}
+	return graph
+}
+
+func TestFloydWarshall(t *testing.T) {
+	graph := map[string][]Edge[string]{
+		"AA": {{To: "BB", Cost: 4}, {To: "CC", Cost: 1}},
+		"CC": {{To: "BB", Cost: 2}},
+		"BB": {{To: "DD", Cost: 5}},
+	}
+	edges := func(n string) []Edge[string] { return graph[n] }
+	all := FloydWarshall([]string{"AA", "BB", "CC", "DD"}, edges)
+
+	path, ok := all.Path("AA", "DD")
+	if expect := []string{"AA", "CC", "BB", "DD"}; !ok || path.Cost != 8 || !reflect.DeepEqual(path.Nodes, expect) {
+		t.Errorf("got %v costing %d, %t, expected %v costing 8", path.Nodes, path.Cost, ok, expect)
+	}
+	if _, ok := all.Cost("DD", "AA"); ok {
+		t.Error("expected DD to have no way back to AA")
+	}
+	if cost, ok := all.Cost("BB", "BB"); !ok || cost != 0 {
+		t.Errorf("got %d, %t to stay put", cost, ok)
+	}
+}
+
+// Each search must agree with the others on random graphs
+func TestAgreement(t *testing.T) {
+	r := rand.New(rand.NewSource(15))
+	for round := 0; round < 50; round++ {
+		const n = 12
+		graph := randomGraph(r, n)
+		edges := func(n int) []Edge[int] { return graph[n] }
+		next := func(n int) (to []int) {
+			for _, edge := range graph[n] {
+				to = append(to, edge.To)
+			}
+			return
+		}
+		nodes := make([]int, n)
+		for i := range nodes {
+			nodes[i] = i
+		}
+		all := FloydWarshall(nodes, edges)
+		unweighted := FloydWarshall(nodes, func(n int) (edges []Edge[int]) {
+			for _, to := range next(n) {
+				edges = append(edges, Edge[int]{To: to, Cost: 1})
+			}
+			return
+		})
+
+		for _, from := range nodes {
+			for _, to := range nodes {
+				expect, reachable := all.Cost(from, to)
+				path, ok := Dijkstra(from, Is(to), edges)
+				if ok != reachable || path.Cost != expect {
+					t.Fatalf("round %d, %d to %d: dijkstra got %d, %t, floyd-warshall %d, %t", round, from, to, path.Cost, ok, expect, reachable)
+				}
+				if fw, _ := all.Path(from, to); ok && fw.Cost != path.Cost {
+					t.Fatalf("round %d, %d to %d: path costs %d, expected %d", round, from, to, fw.Cost, path.Cost)
+				}
+
+				steps, _ := unweighted.Cost(from, to)
+				if path, ok := BFS(from, Is(to), next); ok != reachable || path.Cost != steps {
+					t.Fatalf("round %d, %d to %d: bfs got %d, %t, expected %d, %t", round, from, to, path.Cost, ok, steps, reachable)
+				}
+			}
+		}
+	}
+}
@@ -1,13 +1,28 @@
 package util
 
 import (
+	"context"
 	"embed"
+	"errors"
 	"io"
 )
 
+// ErrUnsolved is returned by a part that has no answer yet, in place of a
+// placeholder that could be mistaken for one
+var ErrUnsolved = errors.New("part not solved yet")
+
 type Solution interface {
 	Files() embed.FS
 	Parse(io.Reader) error
 	Part1(io.Writer) error
 	Part2(io.Writer) error
 }
+
+// Cancellable is a Solution whose parts give up once ctx is done, returning
+// ctx.Err(). The runner calls these in place of Part1 and Part2 so a timed
+// out part stops working rather than running on in the background.
+type Cancellable interface {
+	Solution
+	Part1Context(ctx context.Context, w io.Writer) error
+	Part2Context(ctx context.Context, w io.Writer) error
+}